@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookService_CreateBook_FullMethodName = "/bookstore.BookService/CreateBook"
-	BookService_GetBook_FullMethodName    = "/bookstore.BookService/GetBook"
-	BookService_UpdateBook_FullMethodName = "/bookstore.BookService/UpdateBook"
-	BookService_DeleteBook_FullMethodName = "/bookstore.BookService/DeleteBook"
+	BookService_CreateBook_FullMethodName         = "/bookstore.BookService/CreateBook"
+	BookService_GetBook_FullMethodName            = "/bookstore.BookService/GetBook"
+	BookService_UpdateBook_FullMethodName         = "/bookstore.BookService/UpdateBook"
+	BookService_DeleteBook_FullMethodName         = "/bookstore.BookService/DeleteBook"
+	BookService_StreamBooks_FullMethodName        = "/bookstore.BookService/StreamBooks"
+	BookService_ReserveStock_FullMethodName       = "/bookstore.BookService/ReserveStock"
+	BookService_ConfirmReservation_FullMethodName = "/bookstore.BookService/ConfirmReservation"
+	BookService_ReleaseReservation_FullMethodName = "/bookstore.BookService/ReleaseReservation"
+	BookService_GetStockHistory_FullMethodName    = "/bookstore.BookService/GetStockHistory"
 )
 
 // BookServiceClient is the client API for BookService service.
@@ -37,6 +42,16 @@ type BookServiceClient interface {
 	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*UpdateBookResponse, error)
 	// 删除书籍
 	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error)
+	// 导出全部书籍，每条记录一个消息
+	StreamBooks(ctx context.Context, in *StreamBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Book], error)
+	// 在客户结账前预留库存，过期前没有确认的预留自动释放
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	// 确认预留，按预留时的价格扣款并用预留的库存创建订单
+	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*ConfirmReservationResponse, error)
+	// 释放预留的库存
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error)
+	// 查询书籍的库存变动记录，并核对全部变动之和是否等于当前库存
+	GetStockHistory(ctx context.Context, in *GetStockHistoryRequest, opts ...grpc.CallOption) (*GetStockHistoryResponse, error)
 }
 
 type bookServiceClient struct {
@@ -87,6 +102,65 @@ func (c *bookServiceClient) DeleteBook(ctx context.Context, in *DeleteBookReques
 	return out, nil
 }
 
+func (c *bookServiceClient) StreamBooks(ctx context.Context, in *StreamBooksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Book], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookService_ServiceDesc.Streams[0], BookService_StreamBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamBooksRequest, Book]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookService_StreamBooksClient = grpc.ServerStreamingClient[Book]
+
+func (c *bookServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveStockResponse)
+	err := c.cc.Invoke(ctx, BookService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*ConfirmReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmReservationResponse)
+	err := c.cc.Invoke(ctx, BookService_ConfirmReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseReservationResponse)
+	err := c.cc.Invoke(ctx, BookService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetStockHistory(ctx context.Context, in *GetStockHistoryRequest, opts ...grpc.CallOption) (*GetStockHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockHistoryResponse)
+	err := c.cc.Invoke(ctx, BookService_GetStockHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookServiceServer is the server API for BookService service.
 // All implementations must embed UnimplementedBookServiceServer
 // for forward compatibility.
@@ -99,6 +173,16 @@ type BookServiceServer interface {
 	UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error)
 	// 删除书籍
 	DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error)
+	// 导出全部书籍，每条记录一个消息
+	StreamBooks(*StreamBooksRequest, grpc.ServerStreamingServer[Book]) error
+	// 在客户结账前预留库存，过期前没有确认的预留自动释放
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	// 确认预留，按预留时的价格扣款并用预留的库存创建订单
+	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ConfirmReservationResponse, error)
+	// 释放预留的库存
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
+	// 查询书籍的库存变动记录，并核对全部变动之和是否等于当前库存
+	GetStockHistory(context.Context, *GetStockHistoryRequest) (*GetStockHistoryResponse, error)
 	mustEmbedUnimplementedBookServiceServer()
 }
 
@@ -121,121 +205,697 @@ func (UnimplementedBookServiceServer) UpdateBook(context.Context, *UpdateBookReq
 func (UnimplementedBookServiceServer) DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
 }
-func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
-func (UnimplementedBookServiceServer) testEmbeddedByValue()                     {}
+func (UnimplementedBookServiceServer) StreamBooks(*StreamBooksRequest, grpc.ServerStreamingServer[Book]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBooks not implemented")
+}
+func (UnimplementedBookServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedBookServiceServer) ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
+}
+func (UnimplementedBookServiceServer) ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedBookServiceServer) GetStockHistory(context.Context, *GetStockHistoryRequest) (*GetStockHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockHistory not implemented")
+}
+func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
+func (UnimplementedBookServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeBookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookServiceServer will
+// result in compilation errors.
+type UnsafeBookServiceServer interface {
+	mustEmbedUnimplementedBookServiceServer()
+}
+
+func RegisterBookServiceServer(s grpc.ServiceRegistrar, srv BookServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookService_ServiceDesc, srv)
+}
+
+func _BookService_CreateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_CreateBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateBook(ctx, req.(*CreateBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBook(ctx, req.(*GetBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateBook(ctx, req.(*UpdateBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_DeleteBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteBook(ctx, req.(*DeleteBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_StreamBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookServiceServer).StreamBooks(m, &grpc.GenericServerStream[StreamBooksRequest, Book]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookService_StreamBooksServer = grpc.ServerStreamingServer[Book]
+
+func _BookService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ConfirmReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetStockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetStockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetStockHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetStockHistory(ctx, req.(*GetStockHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookService_ServiceDesc is the grpc.ServiceDesc for BookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookstore.BookService",
+	HandlerType: (*BookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBook",
+			Handler:    _BookService_CreateBook_Handler,
+		},
+		{
+			MethodName: "GetBook",
+			Handler:    _BookService_GetBook_Handler,
+		},
+		{
+			MethodName: "UpdateBook",
+			Handler:    _BookService_UpdateBook_Handler,
+		},
+		{
+			MethodName: "DeleteBook",
+			Handler:    _BookService_DeleteBook_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _BookService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ConfirmReservation",
+			Handler:    _BookService_ConfirmReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _BookService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "GetStockHistory",
+			Handler:    _BookService_GetStockHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBooks",
+			Handler:       _BookService_StreamBooks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "library.proto",
+}
+
+const (
+	PublisherService_CreatePublisher_FullMethodName = "/bookstore.PublisherService/CreatePublisher"
+	PublisherService_GetPublisher_FullMethodName    = "/bookstore.PublisherService/GetPublisher"
+	PublisherService_UpdatePublisher_FullMethodName = "/bookstore.PublisherService/UpdatePublisher"
+	PublisherService_DeletePublisher_FullMethodName = "/bookstore.PublisherService/DeletePublisher"
+)
+
+// PublisherServiceClient is the client API for PublisherService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// --------------------------------------------出版社部分--------------------------------------------
+type PublisherServiceClient interface {
+	// 创建出版社
+	CreatePublisher(ctx context.Context, in *CreatePublisherRequest, opts ...grpc.CallOption) (*CreatePublisherResponse, error)
+	// 获取出版社
+	GetPublisher(ctx context.Context, in *GetPublisherRequest, opts ...grpc.CallOption) (*GetPublisherResponse, error)
+	// 更新出版社信息
+	UpdatePublisher(ctx context.Context, in *UpdatePublisherRequest, opts ...grpc.CallOption) (*UpdatePublisherResponse, error)
+	// 删除出版社
+	DeletePublisher(ctx context.Context, in *DeletePublisherRequest, opts ...grpc.CallOption) (*DeletePublisherResponse, error)
+}
+
+type publisherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPublisherServiceClient(cc grpc.ClientConnInterface) PublisherServiceClient {
+	return &publisherServiceClient{cc}
+}
+
+func (c *publisherServiceClient) CreatePublisher(ctx context.Context, in *CreatePublisherRequest, opts ...grpc.CallOption) (*CreatePublisherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePublisherResponse)
+	err := c.cc.Invoke(ctx, PublisherService_CreatePublisher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherServiceClient) GetPublisher(ctx context.Context, in *GetPublisherRequest, opts ...grpc.CallOption) (*GetPublisherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublisherResponse)
+	err := c.cc.Invoke(ctx, PublisherService_GetPublisher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherServiceClient) UpdatePublisher(ctx context.Context, in *UpdatePublisherRequest, opts ...grpc.CallOption) (*UpdatePublisherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePublisherResponse)
+	err := c.cc.Invoke(ctx, PublisherService_UpdatePublisher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherServiceClient) DeletePublisher(ctx context.Context, in *DeletePublisherRequest, opts ...grpc.CallOption) (*DeletePublisherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePublisherResponse)
+	err := c.cc.Invoke(ctx, PublisherService_DeletePublisher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PublisherServiceServer is the server API for PublisherService service.
+// All implementations must embed UnimplementedPublisherServiceServer
+// for forward compatibility.
+//
+// --------------------------------------------出版社部分--------------------------------------------
+type PublisherServiceServer interface {
+	// 创建出版社
+	CreatePublisher(context.Context, *CreatePublisherRequest) (*CreatePublisherResponse, error)
+	// 获取出版社
+	GetPublisher(context.Context, *GetPublisherRequest) (*GetPublisherResponse, error)
+	// 更新出版社信息
+	UpdatePublisher(context.Context, *UpdatePublisherRequest) (*UpdatePublisherResponse, error)
+	// 删除出版社
+	DeletePublisher(context.Context, *DeletePublisherRequest) (*DeletePublisherResponse, error)
+	mustEmbedUnimplementedPublisherServiceServer()
+}
+
+// UnimplementedPublisherServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPublisherServiceServer struct{}
+
+func (UnimplementedPublisherServiceServer) CreatePublisher(context.Context, *CreatePublisherRequest) (*CreatePublisherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePublisher not implemented")
+}
+func (UnimplementedPublisherServiceServer) GetPublisher(context.Context, *GetPublisherRequest) (*GetPublisherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublisher not implemented")
+}
+func (UnimplementedPublisherServiceServer) UpdatePublisher(context.Context, *UpdatePublisherRequest) (*UpdatePublisherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePublisher not implemented")
+}
+func (UnimplementedPublisherServiceServer) DeletePublisher(context.Context, *DeletePublisherRequest) (*DeletePublisherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePublisher not implemented")
+}
+func (UnimplementedPublisherServiceServer) mustEmbedUnimplementedPublisherServiceServer() {}
+func (UnimplementedPublisherServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafePublisherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PublisherServiceServer will
+// result in compilation errors.
+type UnsafePublisherServiceServer interface {
+	mustEmbedUnimplementedPublisherServiceServer()
+}
+
+func RegisterPublisherServiceServer(s grpc.ServiceRegistrar, srv PublisherServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPublisherServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PublisherService_ServiceDesc, srv)
+}
+
+func _PublisherService_CreatePublisher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePublisherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServiceServer).CreatePublisher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublisherService_CreatePublisher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServiceServer).CreatePublisher(ctx, req.(*CreatePublisherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublisherService_GetPublisher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublisherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServiceServer).GetPublisher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublisherService_GetPublisher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServiceServer).GetPublisher(ctx, req.(*GetPublisherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublisherService_UpdatePublisher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePublisherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServiceServer).UpdatePublisher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublisherService_UpdatePublisher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServiceServer).UpdatePublisher(ctx, req.(*UpdatePublisherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublisherService_DeletePublisher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePublisherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServiceServer).DeletePublisher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublisherService_DeletePublisher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServiceServer).DeletePublisher(ctx, req.(*DeletePublisherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PublisherService_ServiceDesc is the grpc.ServiceDesc for PublisherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PublisherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookstore.PublisherService",
+	HandlerType: (*PublisherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePublisher",
+			Handler:    _PublisherService_CreatePublisher_Handler,
+		},
+		{
+			MethodName: "GetPublisher",
+			Handler:    _PublisherService_GetPublisher_Handler,
+		},
+		{
+			MethodName: "UpdatePublisher",
+			Handler:    _PublisherService_UpdatePublisher_Handler,
+		},
+		{
+			MethodName: "DeletePublisher",
+			Handler:    _PublisherService_DeletePublisher_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "library.proto",
+}
+
+const (
+	SeriesService_CreateSeries_FullMethodName = "/bookstore.SeriesService/CreateSeries"
+	SeriesService_GetSeries_FullMethodName    = "/bookstore.SeriesService/GetSeries"
+	SeriesService_UpdateSeries_FullMethodName = "/bookstore.SeriesService/UpdateSeries"
+	SeriesService_DeleteSeries_FullMethodName = "/bookstore.SeriesService/DeleteSeries"
+)
+
+// SeriesServiceClient is the client API for SeriesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// --------------------------------------------丛书部分--------------------------------------------
+type SeriesServiceClient interface {
+	// 创建丛书
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*CreateSeriesResponse, error)
+	// 获取丛书
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*GetSeriesResponse, error)
+	// 更新丛书信息
+	UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*UpdateSeriesResponse, error)
+	// 删除丛书
+	DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResponse, error)
+}
+
+type seriesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSeriesServiceClient(cc grpc.ClientConnInterface) SeriesServiceClient {
+	return &seriesServiceClient{cc}
+}
+
+func (c *seriesServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*CreateSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSeriesResponse)
+	err := c.cc.Invoke(ctx, SeriesService_CreateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*GetSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeriesResponse)
+	err := c.cc.Invoke(ctx, SeriesService_GetSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*UpdateSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSeriesResponse)
+	err := c.cc.Invoke(ctx, SeriesService_UpdateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSeriesResponse)
+	err := c.cc.Invoke(ctx, SeriesService_DeleteSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SeriesServiceServer is the server API for SeriesService service.
+// All implementations must embed UnimplementedSeriesServiceServer
+// for forward compatibility.
+//
+// --------------------------------------------丛书部分--------------------------------------------
+type SeriesServiceServer interface {
+	// 创建丛书
+	CreateSeries(context.Context, *CreateSeriesRequest) (*CreateSeriesResponse, error)
+	// 获取丛书
+	GetSeries(context.Context, *GetSeriesRequest) (*GetSeriesResponse, error)
+	// 更新丛书信息
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*UpdateSeriesResponse, error)
+	// 删除丛书
+	DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResponse, error)
+	mustEmbedUnimplementedSeriesServiceServer()
+}
+
+// UnimplementedSeriesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSeriesServiceServer struct{}
+
+func (UnimplementedSeriesServiceServer) CreateSeries(context.Context, *CreateSeriesRequest) (*CreateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) GetSeries(context.Context, *GetSeriesRequest) (*GetSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) UpdateSeries(context.Context, *UpdateSeriesRequest) (*UpdateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeries not implemented")
+}
+func (UnimplementedSeriesServiceServer) mustEmbedUnimplementedSeriesServiceServer() {}
+func (UnimplementedSeriesServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeBookServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BookServiceServer will
+// UnsafeSeriesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SeriesServiceServer will
 // result in compilation errors.
-type UnsafeBookServiceServer interface {
-	mustEmbedUnimplementedBookServiceServer()
+type UnsafeSeriesServiceServer interface {
+	mustEmbedUnimplementedSeriesServiceServer()
 }
 
-func RegisterBookServiceServer(s grpc.ServiceRegistrar, srv BookServiceServer) {
-	// If the following call pancis, it indicates UnimplementedBookServiceServer was
+func RegisterSeriesServiceServer(s grpc.ServiceRegistrar, srv SeriesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSeriesServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&BookService_ServiceDesc, srv)
+	s.RegisterService(&SeriesService_ServiceDesc, srv)
 }
 
-func _BookService_CreateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBookRequest)
+func _SeriesService_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).CreateBook(ctx, in)
+		return srv.(SeriesServiceServer).CreateSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_CreateBook_FullMethodName,
+		FullMethod: SeriesService_CreateSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).CreateBook(ctx, req.(*CreateBookRequest))
+		return srv.(SeriesServiceServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_GetBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBookRequest)
+func _SeriesService_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).GetBook(ctx, in)
+		return srv.(SeriesServiceServer).GetSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_GetBook_FullMethodName,
+		FullMethod: SeriesService_GetSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).GetBook(ctx, req.(*GetBookRequest))
+		return srv.(SeriesServiceServer).GetSeries(ctx, req.(*GetSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBookRequest)
+func _SeriesService_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).UpdateBook(ctx, in)
+		return srv.(SeriesServiceServer).UpdateSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_UpdateBook_FullMethodName,
+		FullMethod: SeriesService_UpdateSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).UpdateBook(ctx, req.(*UpdateBookRequest))
+		return srv.(SeriesServiceServer).UpdateSeries(ctx, req.(*UpdateSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBookRequest)
+func _SeriesService_DeleteSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookServiceServer).DeleteBook(ctx, in)
+		return srv.(SeriesServiceServer).DeleteSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookService_DeleteBook_FullMethodName,
+		FullMethod: SeriesService_DeleteSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).DeleteBook(ctx, req.(*DeleteBookRequest))
+		return srv.(SeriesServiceServer).DeleteSeries(ctx, req.(*DeleteSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// BookService_ServiceDesc is the grpc.ServiceDesc for BookService service.
+// SeriesService_ServiceDesc is the grpc.ServiceDesc for SeriesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var BookService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "bookstore.BookService",
-	HandlerType: (*BookServiceServer)(nil),
+var SeriesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookstore.SeriesService",
+	HandlerType: (*SeriesServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateBook",
-			Handler:    _BookService_CreateBook_Handler,
+			MethodName: "CreateSeries",
+			Handler:    _SeriesService_CreateSeries_Handler,
 		},
 		{
-			MethodName: "GetBook",
-			Handler:    _BookService_GetBook_Handler,
+			MethodName: "GetSeries",
+			Handler:    _SeriesService_GetSeries_Handler,
 		},
 		{
-			MethodName: "UpdateBook",
-			Handler:    _BookService_UpdateBook_Handler,
+			MethodName: "UpdateSeries",
+			Handler:    _SeriesService_UpdateSeries_Handler,
 		},
 		{
-			MethodName: "DeleteBook",
-			Handler:    _BookService_DeleteBook_Handler,
+			MethodName: "DeleteSeries",
+			Handler:    _SeriesService_DeleteSeries_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -476,6 +1136,8 @@ const (
 	PurchaseOrderService_UpdatePurchaseOrder_FullMethodName                     = "/bookstore.PurchaseOrderService/UpdatePurchaseOrder"
 	PurchaseOrderService_DeletePurchaseOrder_FullMethodName                     = "/bookstore.PurchaseOrderService/DeletePurchaseOrder"
 	PurchaseOrderService_GeneratePurchaseOrdersFromStockRequests_FullMethodName = "/bookstore.PurchaseOrderService/GeneratePurchaseOrdersFromStockRequests"
+	PurchaseOrderService_StreamPurchaseOrders_FullMethodName                    = "/bookstore.PurchaseOrderService/StreamPurchaseOrders"
+	PurchaseOrderService_ReceivePurchaseOrder_FullMethodName                    = "/bookstore.PurchaseOrderService/ReceivePurchaseOrder"
 )
 
 // PurchaseOrderServiceClient is the client API for PurchaseOrderService service.
@@ -488,7 +1150,12 @@ type PurchaseOrderServiceClient interface {
 	GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*GetPurchaseOrderResponse, error)
 	UpdatePurchaseOrder(ctx context.Context, in *UpdatePurchaseOrderRequest, opts ...grpc.CallOption) (*UpdatePurchaseOrderResponse, error)
 	DeletePurchaseOrder(ctx context.Context, in *DeletePurchaseOrderRequest, opts ...grpc.CallOption) (*DeletePurchaseOrderResponse, error)
+	// 合并未完成的缺书登记，按供应商生成多行采购单，整个生成在一个事务中完成，并发的生成不会重复处理同一条缺书登记
 	GeneratePurchaseOrdersFromStockRequests(ctx context.Context, in *GeneratePurchaseOrdersRequest, opts ...grpc.CallOption) (*GeneratePurchaseOrdersResponse, error)
+	// 导出采购单，每条记录一个消息
+	StreamPurchaseOrders(ctx context.Context, in *StreamPurchaseOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PurchaseOrder], error)
+	// 登记一种书的一次到货，同一个 receipt_id 和 book_no 重复提交只入库一次，各行全部到货后采购单自动结束
+	ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderResponse, error)
 }
 
 type purchaseOrderServiceClient struct {
@@ -549,6 +1216,35 @@ func (c *purchaseOrderServiceClient) GeneratePurchaseOrdersFromStockRequests(ctx
 	return out, nil
 }
 
+func (c *purchaseOrderServiceClient) StreamPurchaseOrders(ctx context.Context, in *StreamPurchaseOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PurchaseOrder], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PurchaseOrderService_ServiceDesc.Streams[0], PurchaseOrderService_StreamPurchaseOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPurchaseOrdersRequest, PurchaseOrder]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PurchaseOrderService_StreamPurchaseOrdersClient = grpc.ServerStreamingClient[PurchaseOrder]
+
+func (c *purchaseOrderServiceClient) ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceivePurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, PurchaseOrderService_ReceivePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PurchaseOrderServiceServer is the server API for PurchaseOrderService service.
 // All implementations must embed UnimplementedPurchaseOrderServiceServer
 // for forward compatibility.
@@ -559,7 +1255,12 @@ type PurchaseOrderServiceServer interface {
 	GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*GetPurchaseOrderResponse, error)
 	UpdatePurchaseOrder(context.Context, *UpdatePurchaseOrderRequest) (*UpdatePurchaseOrderResponse, error)
 	DeletePurchaseOrder(context.Context, *DeletePurchaseOrderRequest) (*DeletePurchaseOrderResponse, error)
+	// 合并未完成的缺书登记，按供应商生成多行采购单，整个生成在一个事务中完成，并发的生成不会重复处理同一条缺书登记
 	GeneratePurchaseOrdersFromStockRequests(context.Context, *GeneratePurchaseOrdersRequest) (*GeneratePurchaseOrdersResponse, error)
+	// 导出采购单，每条记录一个消息
+	StreamPurchaseOrders(*StreamPurchaseOrdersRequest, grpc.ServerStreamingServer[PurchaseOrder]) error
+	// 登记一种书的一次到货，同一个 receipt_id 和 book_no 重复提交只入库一次，各行全部到货后采购单自动结束
+	ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*ReceivePurchaseOrderResponse, error)
 	mustEmbedUnimplementedPurchaseOrderServiceServer()
 }
 
@@ -585,6 +1286,12 @@ func (UnimplementedPurchaseOrderServiceServer) DeletePurchaseOrder(context.Conte
 func (UnimplementedPurchaseOrderServiceServer) GeneratePurchaseOrdersFromStockRequests(context.Context, *GeneratePurchaseOrdersRequest) (*GeneratePurchaseOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeneratePurchaseOrdersFromStockRequests not implemented")
 }
+func (UnimplementedPurchaseOrderServiceServer) StreamPurchaseOrders(*StreamPurchaseOrdersRequest, grpc.ServerStreamingServer[PurchaseOrder]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPurchaseOrders not implemented")
+}
+func (UnimplementedPurchaseOrderServiceServer) ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*ReceivePurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrder not implemented")
+}
 func (UnimplementedPurchaseOrderServiceServer) mustEmbedUnimplementedPurchaseOrderServiceServer() {}
 func (UnimplementedPurchaseOrderServiceServer) testEmbeddedByValue()                              {}
 
@@ -696,6 +1403,35 @@ func _PurchaseOrderService_GeneratePurchaseOrdersFromStockRequests_Handler(srv i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PurchaseOrderService_StreamPurchaseOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPurchaseOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PurchaseOrderServiceServer).StreamPurchaseOrders(m, &grpc.GenericServerStream[StreamPurchaseOrdersRequest, PurchaseOrder]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PurchaseOrderService_StreamPurchaseOrdersServer = grpc.ServerStreamingServer[PurchaseOrder]
+
+func _PurchaseOrderService_ReceivePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchaseOrderServiceServer).ReceivePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchaseOrderService_ReceivePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchaseOrderServiceServer).ReceivePurchaseOrder(ctx, req.(*ReceivePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PurchaseOrderService_ServiceDesc is the grpc.ServiceDesc for PurchaseOrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -723,6 +1459,126 @@ var PurchaseOrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GeneratePurchaseOrdersFromStockRequests",
 			Handler:    _PurchaseOrderService_GeneratePurchaseOrdersFromStockRequests_Handler,
 		},
+		{
+			MethodName: "ReceivePurchaseOrder",
+			Handler:    _PurchaseOrderService_ReceivePurchaseOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPurchaseOrders",
+			Handler:       _PurchaseOrderService_StreamPurchaseOrders_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "library.proto",
+}
+
+const (
+	ForecastService_ForecastDemand_FullMethodName = "/bookstore.ForecastService/ForecastDemand"
+)
+
+// ForecastServiceClient is the client API for ForecastService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 需求预测服务
+type ForecastServiceClient interface {
+	// 根据客户订单的销量预测每本书的日需求，估计现有库存可以支撑的天数并给出建议的补货数量，
+	// 设置 create_stock_requests 时为建议补货的书创建缺书登记，之后由 GeneratePurchaseOrdersFromStockRequests 生成采购单
+	ForecastDemand(ctx context.Context, in *ForecastDemandRequest, opts ...grpc.CallOption) (*ForecastDemandResponse, error)
+}
+
+type forecastServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewForecastServiceClient(cc grpc.ClientConnInterface) ForecastServiceClient {
+	return &forecastServiceClient{cc}
+}
+
+func (c *forecastServiceClient) ForecastDemand(ctx context.Context, in *ForecastDemandRequest, opts ...grpc.CallOption) (*ForecastDemandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForecastDemandResponse)
+	err := c.cc.Invoke(ctx, ForecastService_ForecastDemand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ForecastServiceServer is the server API for ForecastService service.
+// All implementations must embed UnimplementedForecastServiceServer
+// for forward compatibility.
+//
+// 需求预测服务
+type ForecastServiceServer interface {
+	// 根据客户订单的销量预测每本书的日需求，估计现有库存可以支撑的天数并给出建议的补货数量，
+	// 设置 create_stock_requests 时为建议补货的书创建缺书登记，之后由 GeneratePurchaseOrdersFromStockRequests 生成采购单
+	ForecastDemand(context.Context, *ForecastDemandRequest) (*ForecastDemandResponse, error)
+	mustEmbedUnimplementedForecastServiceServer()
+}
+
+// UnimplementedForecastServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedForecastServiceServer struct{}
+
+func (UnimplementedForecastServiceServer) ForecastDemand(context.Context, *ForecastDemandRequest) (*ForecastDemandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForecastDemand not implemented")
+}
+func (UnimplementedForecastServiceServer) mustEmbedUnimplementedForecastServiceServer() {}
+func (UnimplementedForecastServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeForecastServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ForecastServiceServer will
+// result in compilation errors.
+type UnsafeForecastServiceServer interface {
+	mustEmbedUnimplementedForecastServiceServer()
+}
+
+func RegisterForecastServiceServer(s grpc.ServiceRegistrar, srv ForecastServiceServer) {
+	// If the following call pancis, it indicates UnimplementedForecastServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ForecastService_ServiceDesc, srv)
+}
+
+func _ForecastService_ForecastDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastDemandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForecastServiceServer).ForecastDemand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForecastService_ForecastDemand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForecastServiceServer).ForecastDemand(ctx, req.(*ForecastDemandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ForecastService_ServiceDesc is the grpc.ServiceDesc for ForecastService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ForecastService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookstore.ForecastService",
+	HandlerType: (*ForecastServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ForecastDemand",
+			Handler:    _ForecastService_ForecastDemand_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "library.proto",
@@ -957,10 +1813,12 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CustomerOrderService_CreateCustomerOrder_FullMethodName = "/bookstore.CustomerOrderService/CreateCustomerOrder"
-	CustomerOrderService_GetCustomerOrder_FullMethodName    = "/bookstore.CustomerOrderService/GetCustomerOrder"
-	CustomerOrderService_UpdateCustomerOrder_FullMethodName = "/bookstore.CustomerOrderService/UpdateCustomerOrder"
-	CustomerOrderService_DeleteCustomerOrder_FullMethodName = "/bookstore.CustomerOrderService/DeleteCustomerOrder"
+	CustomerOrderService_CreateCustomerOrder_FullMethodName  = "/bookstore.CustomerOrderService/CreateCustomerOrder"
+	CustomerOrderService_GetCustomerOrder_FullMethodName     = "/bookstore.CustomerOrderService/GetCustomerOrder"
+	CustomerOrderService_UpdateCustomerOrder_FullMethodName  = "/bookstore.CustomerOrderService/UpdateCustomerOrder"
+	CustomerOrderService_DeleteCustomerOrder_FullMethodName  = "/bookstore.CustomerOrderService/DeleteCustomerOrder"
+	CustomerOrderService_CancelCustomerOrder_FullMethodName  = "/bookstore.CustomerOrderService/CancelCustomerOrder"
+	CustomerOrderService_StreamCustomerOrders_FullMethodName = "/bookstore.CustomerOrderService/StreamCustomerOrders"
 )
 
 // CustomerOrderServiceClient is the client API for CustomerOrderService service.
@@ -973,6 +1831,9 @@ type CustomerOrderServiceClient interface {
 	GetCustomerOrder(ctx context.Context, in *GetCustomerOrderRequest, opts ...grpc.CallOption) (*GetCustomerOrderResponse, error)
 	UpdateCustomerOrder(ctx context.Context, in *UpdateCustomerOrderRequest, opts ...grpc.CallOption) (*UpdateCustomerOrderResponse, error)
 	DeleteCustomerOrder(ctx context.Context, in *DeleteCustomerOrderRequest, opts ...grpc.CallOption) (*DeleteCustomerOrderResponse, error)
+	CancelCustomerOrder(ctx context.Context, in *CancelCustomerOrderRequest, opts ...grpc.CallOption) (*CancelCustomerOrderResponse, error)
+	// 导出客户订单，每条记录一个消息
+	StreamCustomerOrders(ctx context.Context, in *StreamCustomerOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CustomerOrder], error)
 }
 
 type customerOrderServiceClient struct {
@@ -1023,6 +1884,35 @@ func (c *customerOrderServiceClient) DeleteCustomerOrder(ctx context.Context, in
 	return out, nil
 }
 
+func (c *customerOrderServiceClient) CancelCustomerOrder(ctx context.Context, in *CancelCustomerOrderRequest, opts ...grpc.CallOption) (*CancelCustomerOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCustomerOrderResponse)
+	err := c.cc.Invoke(ctx, CustomerOrderService_CancelCustomerOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerOrderServiceClient) StreamCustomerOrders(ctx context.Context, in *StreamCustomerOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CustomerOrder], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CustomerOrderService_ServiceDesc.Streams[0], CustomerOrderService_StreamCustomerOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamCustomerOrdersRequest, CustomerOrder]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerOrderService_StreamCustomerOrdersClient = grpc.ServerStreamingClient[CustomerOrder]
+
 // CustomerOrderServiceServer is the server API for CustomerOrderService service.
 // All implementations must embed UnimplementedCustomerOrderServiceServer
 // for forward compatibility.
@@ -1033,6 +1923,9 @@ type CustomerOrderServiceServer interface {
 	GetCustomerOrder(context.Context, *GetCustomerOrderRequest) (*GetCustomerOrderResponse, error)
 	UpdateCustomerOrder(context.Context, *UpdateCustomerOrderRequest) (*UpdateCustomerOrderResponse, error)
 	DeleteCustomerOrder(context.Context, *DeleteCustomerOrderRequest) (*DeleteCustomerOrderResponse, error)
+	CancelCustomerOrder(context.Context, *CancelCustomerOrderRequest) (*CancelCustomerOrderResponse, error)
+	// 导出客户订单，每条记录一个消息
+	StreamCustomerOrders(*StreamCustomerOrdersRequest, grpc.ServerStreamingServer[CustomerOrder]) error
 	mustEmbedUnimplementedCustomerOrderServiceServer()
 }
 
@@ -1055,6 +1948,12 @@ func (UnimplementedCustomerOrderServiceServer) UpdateCustomerOrder(context.Conte
 func (UnimplementedCustomerOrderServiceServer) DeleteCustomerOrder(context.Context, *DeleteCustomerOrderRequest) (*DeleteCustomerOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomerOrder not implemented")
 }
+func (UnimplementedCustomerOrderServiceServer) CancelCustomerOrder(context.Context, *CancelCustomerOrderRequest) (*CancelCustomerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCustomerOrder not implemented")
+}
+func (UnimplementedCustomerOrderServiceServer) StreamCustomerOrders(*StreamCustomerOrdersRequest, grpc.ServerStreamingServer[CustomerOrder]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCustomerOrders not implemented")
+}
 func (UnimplementedCustomerOrderServiceServer) mustEmbedUnimplementedCustomerOrderServiceServer() {}
 func (UnimplementedCustomerOrderServiceServer) testEmbeddedByValue()                              {}
 
@@ -1148,6 +2047,35 @@ func _CustomerOrderService_DeleteCustomerOrder_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomerOrderService_CancelCustomerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCustomerOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerOrderServiceServer).CancelCustomerOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerOrderService_CancelCustomerOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerOrderServiceServer).CancelCustomerOrder(ctx, req.(*CancelCustomerOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerOrderService_StreamCustomerOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCustomerOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CustomerOrderServiceServer).StreamCustomerOrders(m, &grpc.GenericServerStream[StreamCustomerOrdersRequest, CustomerOrder]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CustomerOrderService_StreamCustomerOrdersServer = grpc.ServerStreamingServer[CustomerOrder]
+
 // CustomerOrderService_ServiceDesc is the grpc.ServiceDesc for CustomerOrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1171,8 +2099,18 @@ var CustomerOrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCustomerOrder",
 			Handler:    _CustomerOrderService_DeleteCustomerOrder_Handler,
 		},
+		{
+			MethodName: "CancelCustomerOrder",
+			Handler:    _CustomerOrderService_CancelCustomerOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCustomerOrders",
+			Handler:       _CustomerOrderService_StreamCustomerOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "library.proto",
 }
 
@@ -1801,3 +2739,111 @@ var OnlineService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "library.proto",
 }
+
+const (
+	AuthService_Login_FullMethodName = "/bookstore.AuthService/Login"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 登录认证服务
+type AuthServiceClient interface {
+	// 客户登录，成功后签发令牌
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility.
+//
+// 登录认证服务
+type AuthServiceServer interface {
+	// 客户登录，成功后签发令牌
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bookstore.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _AuthService_Login_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "library.proto",
+}
@@ -1,11 +1,27 @@
 package main
 
 import (
+	"flag"
+	"log"
+	"os"
+
 	"github.com/GoldenStain/goDB/server"
 )
 
 func main() {
+	configPath := flag.String("config", "", "配置文件路径，未指定时使用 GODB_CONFIG 环境变量或 server/config.json")
+	flag.Parse()
+
+	config := server.LoadConfigFromFlag(*configPath)
+
+	// migrate 命令只执行数据库迁移，不启动服务
+	if flag.Arg(0) == "migrate" {
+		if err := server.RunMigrate(config, flag.Args()[1:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
-	db := server.ConnectDB()
-	server.StartServer(db)
+	db := server.ConnectDB(config)
+	server.StartServer(db, config)
 }
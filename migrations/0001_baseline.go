@@ -0,0 +1,194 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 以下是引入版本迁移时的表结构快照，之后的结构变更必须写成新的迁移，不能修改这里的定义。
+// 已有的数据库由旧版本的自动迁移创建，AutoMigrate 只会补充缺少的表和列，所以对新旧数据库都适用。
+
+type baselinePublisher struct {
+	ID          int32  `gorm:"primaryKey"`
+	Name        string `gorm:"unique;not null;size:255"`
+	ContactInfo string `gorm:"size:1024"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (baselinePublisher) TableName() string { return "publishers" }
+
+type baselineSeries struct {
+	ID          int32  `gorm:"primaryKey"`
+	Name        string `gorm:"unique;not null;size:255"`
+	Description string `gorm:"size:1024"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (baselineSeries) TableName() string { return "series" }
+
+type baselineBook struct {
+	ID            int32              `gorm:"primaryKey"`
+	BookNo        string             `gorm:"unique;not null;size:255"`
+	Title         string             `gorm:"size:255;not null"`
+	PublisherName string             `gorm:"size:255"`
+	PublisherID   *int32             `gorm:"index"`
+	Publisher     *baselinePublisher `gorm:"foreignKey:PublisherID"`
+	SeriesID      *int32             `gorm:"index"`
+	Series        *baselineSeries    `gorm:"foreignKey:SeriesID"`
+	Price         int32              `gorm:"not null;default:0"`
+	Keywords      string             `gorm:"size:1024"`
+	Authors       string             `gorm:"size:1024"`
+	StockQuantity int32
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (baselineBook) TableName() string { return "books" }
+
+type baselineCustomer struct {
+	ID             int32                    `gorm:"primaryKey"`
+	OnlineID       string                   `gorm:"unique;not null;size:255"`
+	Password       string                   `gorm:"size:255;not null"`
+	Role           string                   `gorm:"size:50;not null;default:'customer'"`
+	Name           string                   `gorm:"size:255;not null"`
+	Address        string                   `gorm:"size:512;not null"`
+	AccountBalance int32                    `gorm:"not null;default:0"`
+	CreditLevel    int32                    `gorm:"not null"`
+	CustomerOrders []*baselineCustomerOrder `gorm:"foreignKey:CustomerOnlineID;references:OnlineID"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (baselineCustomer) TableName() string { return "customers" }
+
+type baselineCustomerOrder struct {
+	ID               int32                                    `gorm:"primaryKey"`
+	OrderDate        string                                   `gorm:"not null;size:50"`
+	CustomerOnlineID string                                   `gorm:"size:255"`
+	Price            int32                                    `gorm:"not null;default:0"`
+	PaidAmount       int32                                    `gorm:"not null;default:0"`
+	RefundAmount     int32                                    `gorm:"not null;default:0"`
+	Address          string                                   `gorm:"size:512;not null"`
+	Status           string                                   `gorm:"not null;size:50;default:'paid'"`
+	Items            []*baselineCustomerOrderItem             `gorm:"foreignKey:OrderID"`
+	StatusHistory    []*baselineCustomerOrderStatusTransition `gorm:"foreignKey:OrderID"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (baselineCustomerOrder) TableName() string { return "customer_orders" }
+
+type baselineCustomerOrderItem struct {
+	ID        int32  `gorm:"primaryKey"`
+	OrderID   int32  `gorm:"not null;index"`
+	BookNo    string `gorm:"not null;size:255;index"`
+	BookCount int32  `gorm:"not null"`
+	Price     int32  `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (baselineCustomerOrderItem) TableName() string { return "customer_order_items" }
+
+type baselineCustomerOrderStatusTransition struct {
+	ID         int32  `gorm:"primaryKey"`
+	OrderID    int32  `gorm:"not null;index"`
+	FromStatus string `gorm:"size:50"`
+	ToStatus   string `gorm:"not null;size:50"`
+	CreatedAt  time.Time
+}
+
+func (baselineCustomerOrderStatusTransition) TableName() string {
+	return "customer_order_status_transitions"
+}
+
+type baselineStockRequest struct {
+	ID          int32  `gorm:"primaryKey"`
+	BookNo      string `gorm:"not null;size:255"`
+	Title       string `gorm:"size:255;not null"`
+	Quantity    int32  `gorm:"not null"`
+	RequestDate string `gorm:"not null;size:50"`
+	Publisher   string `gorm:"size:255"`
+	Author      string `gorm:"size:255"`
+	Supplier    string `gorm:"size:255"`
+	Finished    bool   `gorm:"not null;default:false"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (baselineStockRequest) TableName() string { return "stock_requests" }
+
+type baselinePurchaseOrder struct {
+	ID        int32  `gorm:"primaryKey"`
+	BookNo    string `gorm:"not null;size:255"`
+	Title     string `gorm:"size:255;not null"`
+	Publisher string `gorm:"size:255;not null"`
+	Supplier  string `gorm:"not null;size:255"`
+	Author    string `gorm:"size:255;not null"`
+	Quantity  int32  `gorm:"not null"`
+	OrderDate string `gorm:"not null;size:50"`
+	Finished  bool   `gorm:"not null;default:false"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (baselinePurchaseOrder) TableName() string { return "purchase_orders" }
+
+type baselineSupplyBook struct {
+	ID            int32  `gorm:"primaryKey"`
+	BookNo        string `gorm:"unique;not null;size:255"`
+	Title         string `gorm:"size:255;not null"`
+	PublisherName string `gorm:"size:255"`
+	Price         int32  `gorm:"not null;default:0"`
+	Quantity      int32  `gorm:"not null;default:0"`
+	SupplierID    int32  `gorm:"not null;default:0"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (baselineSupplyBook) TableName() string { return "supply_books" }
+
+type baselineSupplier struct {
+	ID          int32                 `gorm:"primaryKey"`
+	Name        string                `gorm:"size:255;not null"`
+	BasicInfo   string                `gorm:"size:1024"`
+	SupplyInfo  string                `gorm:"size:1024"`
+	SupplyBooks []*baselineSupplyBook `gorm:"foreignKey:SupplierID"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (baselineSupplier) TableName() string { return "suppliers" }
+
+// baselineTables 按依赖顺序排列，被引用的表在前
+var baselineTables = []interface{}{
+	&baselinePublisher{},
+	&baselineSeries{},
+	&baselineBook{},
+	&baselineCustomer{},
+	&baselineStockRequest{},
+	&baselinePurchaseOrder{},
+	&baselineCustomerOrder{},
+	&baselineCustomerOrderItem{},
+	&baselineCustomerOrderStatusTransition{},
+	&baselineSupplier{},
+	&baselineSupplyBook{},
+}
+
+// baselineUp 创建引入版本迁移时的全部表
+func baselineUp(tx *gorm.DB) error {
+	return tx.AutoMigrate(baselineTables...)
+}
+
+// baselineDown 按依赖的相反顺序删除全部表
+func baselineDown(tx *gorm.DB) error {
+	for i := len(baselineTables) - 1; i >= 0; i-- {
+		if err := tx.Migrator().DropTable(baselineTables[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
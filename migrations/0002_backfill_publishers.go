@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// backfillPublishersUp 把书籍中旧的 publisher_name 迁移为出版社记录，并回填 publisher_id
+func backfillPublishersUp(tx *gorm.DB) error {
+	var names []string
+	if err := tx.Model(&baselineBook{}).
+		Where("publisher_id IS NULL AND publisher_name <> ?", "").
+		Distinct().Pluck("publisher_name", &names).Error; err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		publisher := baselinePublisher{Name: name}
+		if err := tx.Where(baselinePublisher{Name: name}).FirstOrCreate(&publisher).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&baselineBook{}).
+			Where("publisher_id IS NULL AND publisher_name = ?", name).
+			Update("publisher_id", publisher.ID).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillPublishersDown 回填只补充了数据，publisher_name 仍然保留，撤销时无须处理
+func backfillPublishersDown(tx *gorm.DB) error {
+	return nil
+}
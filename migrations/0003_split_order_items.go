@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// legacyOrderColumns 是拆分明细之前订单表上的书号和数量列
+type legacyOrderColumns struct {
+	BookNo    string `gorm:"size:255"`
+	BookCount int32
+}
+
+func (legacyOrderColumns) TableName() string { return "customer_orders" }
+
+// splitOrderItemsUp 把旧的单书订单迁移为只有一行明细的订单，然后删除订单表上旧的书号和数量列
+func splitOrderItemsUp(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if !migrator.HasColumn(&legacyOrderColumns{}, "book_no") {
+		return nil
+	}
+
+	var legacyOrders []struct {
+		ID        int32
+		BookNo    string
+		BookCount int32
+		Price     int32
+	}
+	if err := tx.Table("customer_orders").
+		Select("id, book_no, book_count, price").
+		Where("id NOT IN (?)", tx.Model(&baselineCustomerOrderItem{}).Select("order_id")).
+		Scan(&legacyOrders).Error; err != nil {
+		return err
+	}
+
+	for _, order := range legacyOrders {
+		item := baselineCustomerOrderItem{
+			OrderID:   order.ID,
+			BookNo:    order.BookNo,
+			BookCount: order.BookCount,
+			Price:     order.Price,
+		}
+		if err := tx.Create(&item).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, column := range []string{"BookNo", "BookCount"} {
+		if err := migrator.DropColumn(&legacyOrderColumns{}, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitOrderItemsDown 恢复订单表上的书号和数量列，并用订单的第一行明细回填
+func splitOrderItemsDown(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	for _, column := range []string{"BookNo", "BookCount"} {
+		if migrator.HasColumn(&legacyOrderColumns{}, column) {
+			continue
+		}
+		if err := migrator.AddColumn(&legacyOrderColumns{}, column); err != nil {
+			return err
+		}
+	}
+
+	// 订单第一行明细中某一列的子查询
+	firstItem := func(column string) *gorm.DB {
+		return tx.Model(&baselineCustomerOrderItem{}).
+			Select(column).
+			Where("customer_order_items.order_id = customer_orders.id").
+			Order("customer_order_items.id").Limit(1)
+	}
+	return tx.Table("customer_orders").Where("1 = 1").Updates(map[string]interface{}{
+		"book_no":    firstItem("book_no"),
+		"book_count": firstItem("book_count"),
+	}).Error
+}
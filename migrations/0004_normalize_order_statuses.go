@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// 以下是版本 4 时的订单状态和旧版本中文状态的对应关系，之后状态的定义改变时不能修改这里。
+
+// normalizedOrderStatuses 是版本 4 时的标准状态
+var normalizedOrderStatuses = map[string]bool{
+	"pending":   true,
+	"paid":      true,
+	"shipped":   true,
+	"delivered": true,
+	"cancelled": true,
+	"returned":  true,
+}
+
+// legacyOrderStatuses 是旧版本使用的中文状态
+var legacyOrderStatuses = map[string]string{
+	"未发货": "paid",
+	"已发货": "shipped",
+	"已送达": "delivered",
+	"已收货": "delivered",
+	"已取消": "cancelled",
+	"已退货": "returned",
+}
+
+// normalizeOrderStatus 按版本 4 时的规则解析订单状态，兼容大小写不同的英文状态和旧版本的中文状态
+func normalizeOrderStatus(value string) (string, bool) {
+	if status, ok := legacyOrderStatuses[value]; ok {
+		return status, true
+	}
+	status := strings.ToLower(strings.TrimSpace(value))
+	return status, normalizedOrderStatuses[status]
+}
+
+// normalizeOrderStatusesUp 把旧的自由文本状态转换为标准状态，无法识别的状态按已付款处理，因为旧订单在创建时已经扣款
+func normalizeOrderStatusesUp(tx *gorm.DB) error {
+	var statuses []string
+	if err := tx.Model(&baselineCustomerOrder{}).Distinct().Pluck("status", &statuses).Error; err != nil {
+		return err
+	}
+
+	for _, value := range statuses {
+		status, ok := normalizeOrderStatus(value)
+		if !ok {
+			status = "paid"
+		}
+		if status == value {
+			continue
+		}
+		if err := tx.Model(&baselineCustomerOrder{}).Where("status = ?", value).Update("status", status).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeOrderStatusesDown 标准状态仍然可以被解析，撤销时无须处理
+func normalizeOrderStatusesDown(tx *gorm.DB) error {
+	return nil
+}
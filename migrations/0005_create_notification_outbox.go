@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// outboxMessage 是版本 5 时的通知发件箱表结构
+type outboxMessage struct {
+	ID            int32     `gorm:"primaryKey"`
+	Event         string    `gorm:"size:100;not null"`
+	Recipient     string    `gorm:"size:255;not null"`
+	Email         string    `gorm:"size:255"`
+	Subject       string    `gorm:"size:512;not null"`
+	Body          string    `gorm:"type:text;not null"`
+	Status        string    `gorm:"size:20;not null;index:idx_outbox_due,priority:1"`
+	Attempts      int32     `gorm:"not null;default:0"`
+	NextAttemptAt time.Time `gorm:"index:idx_outbox_due,priority:2"`
+	LastError     string    `gorm:"size:1024"`
+	SentAt        *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (outboxMessage) TableName() string { return "outbox_messages" }
+
+// createNotificationOutboxUp 创建通知发件箱表
+func createNotificationOutboxUp(tx *gorm.DB) error {
+	return tx.Migrator().CreateTable(&outboxMessage{})
+}
+
+// createNotificationOutboxDown 删除通知发件箱表，未发送的通知一并删除
+func createNotificationOutboxDown(tx *gorm.DB) error {
+	return tx.Migrator().DropTable(&outboxMessage{})
+}
@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// customerContacts 是版本 6 时客户表上的联系方式和通知偏好列
+type customerContacts struct {
+	Email                   string `gorm:"size:255"`
+	Phone                   string `gorm:"size:50"`
+	NotificationPreferences string `gorm:"type:text"`
+}
+
+func (customerContacts) TableName() string { return "customers" }
+
+// outboxChannel 是版本 6 时发件箱表上的通知渠道和手机号列
+type outboxChannel struct {
+	Channel string `gorm:"size:20;not null;default:'email'"`
+	Phone   string `gorm:"size:50"`
+}
+
+func (outboxChannel) TableName() string { return "outbox_messages" }
+
+// addCustomerContactsUp 为客户添加邮箱、手机号和通知偏好，为发件箱添加通知渠道，已有的通知都是邮件
+func addCustomerContactsUp(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	for _, field := range []string{"Email", "Phone", "NotificationPreferences"} {
+		if err := migrator.AddColumn(&customerContacts{}, field); err != nil {
+			return err
+		}
+	}
+	for _, field := range []string{"Channel", "Phone"} {
+		if err := migrator.AddColumn(&outboxChannel{}, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addCustomerContactsDown 删除新增的列
+func addCustomerContactsDown(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	for _, field := range []string{"Email", "Phone", "NotificationPreferences"} {
+		if err := migrator.DropColumn(&customerContacts{}, field); err != nil {
+			return err
+		}
+	}
+	for _, field := range []string{"Channel", "Phone"} {
+		if err := migrator.DropColumn(&outboxChannel{}, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
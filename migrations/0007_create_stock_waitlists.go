@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// stockWaitlist 是版本 7 时的等待到货登记表结构
+type stockWaitlist struct {
+	ID               int32  `gorm:"primaryKey"`
+	CustomerOnlineID string `gorm:"size:255;not null;index"`
+	BookNo           string `gorm:"size:255;not null;index:idx_stock_waitlist_due,priority:1"`
+	Quantity         int32  `gorm:"not null"`
+	Price            int32  `gorm:"not null"`
+	Address          string `gorm:"size:512;not null"`
+	AutoFulfill      bool   `gorm:"not null;default:false"`
+	StockRequestID   int32  `gorm:"index"`
+	Status           string `gorm:"size:20;not null;index:idx_stock_waitlist_due,priority:2"`
+	CustomerOrderID  *int32
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (stockWaitlist) TableName() string { return "stock_waitlists" }
+
+// createStockWaitlistsUp 创建等待到货登记表。
+// 旧版本没有记录缺货时等待的客户，已有的缺书登记没有对应的等待记录。
+func createStockWaitlistsUp(tx *gorm.DB) error {
+	return tx.Migrator().CreateTable(&stockWaitlist{})
+}
+
+// createStockWaitlistsDown 删除等待到货登记表
+func createStockWaitlistsDown(tx *gorm.DB) error {
+	return tx.Migrator().DropTable(&stockWaitlist{})
+}
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// backorderedItem 是版本 8 时订单明细表上的缺货数量列
+type backorderedItem struct {
+	BackorderedCount int32 `gorm:"not null;default:0"`
+}
+
+func (backorderedItem) TableName() string { return "customer_order_items" }
+
+// backorderStockRequest 是版本 8 时缺书登记表上的订单列
+type backorderStockRequest struct {
+	CustomerOrderID *int32 `gorm:"index"`
+}
+
+func (backorderStockRequest) TableName() string { return "stock_requests" }
+
+// addBackordersUp 为订单明细添加缺货数量，为缺书登记添加对应的缺货订单，已有的订单都没有缺货
+func addBackordersUp(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.AddColumn(&backorderedItem{}, "BackorderedCount"); err != nil {
+		return err
+	}
+	if err := migrator.AddColumn(&backorderStockRequest{}, "CustomerOrderID"); err != nil {
+		return err
+	}
+	return migrator.CreateIndex(&backorderStockRequest{}, "CustomerOrderID")
+}
+
+// addBackordersDown 删除新增的列和索引
+func addBackordersDown(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.DropIndex(&backorderStockRequest{}, "CustomerOrderID"); err != nil {
+		return err
+	}
+	if err := migrator.DropColumn(&backorderStockRequest{}, "CustomerOrderID"); err != nil {
+		return err
+	}
+	return migrator.DropColumn(&backorderedItem{}, "BackorderedCount")
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// reservedBook 是版本 9 时书籍表上的预留数量列
+type reservedBook struct {
+	ReservedQuantity int32 `gorm:"not null;default:0"`
+}
+
+func (reservedBook) TableName() string { return "books" }
+
+// stockReservation 是版本 9 时的库存预留表结构
+type stockReservation struct {
+	ID               int32     `gorm:"primaryKey"`
+	CustomerOnlineID string    `gorm:"size:255;not null;index"`
+	Status           string    `gorm:"size:20;not null;index:idx_stock_reservation_due,priority:1"`
+	ExpiresAt        time.Time `gorm:"not null;index:idx_stock_reservation_due,priority:2"`
+	CustomerOrderID  *int32
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (stockReservation) TableName() string { return "stock_reservations" }
+
+// stockReservationItem 是版本 9 时的库存预留明细表结构
+type stockReservationItem struct {
+	ID            int32  `gorm:"primaryKey"`
+	ReservationID int32  `gorm:"not null;index"`
+	BookNo        string `gorm:"not null;size:255"`
+	Quantity      int32  `gorm:"not null"`
+	Price         int32  `gorm:"not null;default:0"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (stockReservationItem) TableName() string { return "stock_reservation_items" }
+
+// createStockReservationsUp 为书籍添加预留数量，创建库存预留表和明细表
+func createStockReservationsUp(tx *gorm.DB) error {
+	if err := tx.Migrator().AddColumn(&reservedBook{}, "ReservedQuantity"); err != nil {
+		return err
+	}
+	return tx.Migrator().CreateTable(&stockReservation{}, &stockReservationItem{})
+}
+
+// createStockReservationsDown 删除库存预留表和书籍的预留数量，未确认的预留一并删除
+func createStockReservationsDown(tx *gorm.DB) error {
+	if err := tx.Migrator().DropTable(&stockReservationItem{}, &stockReservation{}); err != nil {
+		return err
+	}
+	return tx.Migrator().DropColumn(&reservedBook{}, "ReservedQuantity")
+}
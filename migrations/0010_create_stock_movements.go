@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// stockMovement 是版本 10 时的库存变动表结构
+type stockMovement struct {
+	ID          int32     `gorm:"primaryKey"`
+	BookNo      string    `gorm:"size:255;not null;index:idx_stock_movement_book,priority:1"`
+	Delta       int32     `gorm:"not null"`
+	Reason      string    `gorm:"size:20;not null"`
+	ReferenceID int32     `gorm:"not null;default:0"`
+	Actor       string    `gorm:"size:255;not null"`
+	CreatedAt   time.Time `gorm:"index:idx_stock_movement_book,priority:2"`
+}
+
+func (stockMovement) TableName() string { return "stock_movements" }
+
+// createStockMovementsUp 创建库存变动表，并为已有的库存写入一条期初调整，使变动之和等于当前库存
+func createStockMovementsUp(tx *gorm.DB) error {
+	if err := tx.Migrator().CreateTable(&stockMovement{}); err != nil {
+		return err
+	}
+
+	var books []baselineBook
+	if err := tx.Where("stock_quantity <> ?", 0).Order("id").Find(&books).Error; err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, book := range books {
+		movement := stockMovement{
+			BookNo:    book.BookNo,
+			Delta:     book.StockQuantity,
+			Reason:    "adjustment",
+			Actor:     "system",
+			CreatedAt: now,
+		}
+		if err := tx.Create(&movement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createStockMovementsDown 删除库存变动表，变动记录一并删除
+func createStockMovementsDown(tx *gorm.DB) error {
+	return tx.Migrator().DropTable(&stockMovement{})
+}
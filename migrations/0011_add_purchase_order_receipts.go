@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// receivedPurchaseOrder 是版本 11 时采购单表上的到货数量列
+type receivedPurchaseOrder struct {
+	ReceivedQuantity int32 `gorm:"not null;default:0"`
+}
+
+func (receivedPurchaseOrder) TableName() string { return "purchase_orders" }
+
+// purchaseOrderReceipt 是版本 11 时的采购单到货表结构
+type purchaseOrderReceipt struct {
+	ID              int32  `gorm:"primaryKey"`
+	PurchaseOrderID int32  `gorm:"not null;uniqueIndex:idx_purchase_order_receipt,priority:1"`
+	ReceiptID       string `gorm:"size:255;not null;uniqueIndex:idx_purchase_order_receipt,priority:2"`
+	Quantity        int32  `gorm:"not null"`
+	Actor           string `gorm:"size:255;not null"`
+	CreatedAt       time.Time
+}
+
+func (purchaseOrderReceipt) TableName() string { return "purchase_order_receipts" }
+
+// addPurchaseOrderReceiptsUp 为采购单添加到货数量并创建到货表，已经完成的采购单视为全部到货
+func addPurchaseOrderReceiptsUp(tx *gorm.DB) error {
+	if err := tx.Migrator().AddColumn(&receivedPurchaseOrder{}, "ReceivedQuantity"); err != nil {
+		return err
+	}
+	if err := tx.Model(&baselinePurchaseOrder{}).Where("finished = ?", true).
+		Update("received_quantity", gorm.Expr("quantity")).Error; err != nil {
+		return err
+	}
+	return tx.Migrator().CreateTable(&purchaseOrderReceipt{})
+}
+
+// addPurchaseOrderReceiptsDown 删除到货表和到货数量，部分到货的采购单仍然是未完成
+func addPurchaseOrderReceiptsDown(tx *gorm.DB) error {
+	if err := tx.Migrator().DropTable(&purchaseOrderReceipt{}); err != nil {
+		return err
+	}
+	return tx.Migrator().DropColumn(&receivedPurchaseOrder{}, "ReceivedQuantity")
+}
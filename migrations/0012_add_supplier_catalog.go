@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// catalogSupplyBook 是版本 12 时的供书目录唯一索引，同一本书可以由多个供应商供货
+type catalogSupplyBook struct {
+	BookNo     string `gorm:"not null;size:255;uniqueIndex:idx_supply_book_supplier,priority:2"`
+	SupplierID int32  `gorm:"not null;default:0;uniqueIndex:idx_supply_book_supplier,priority:1"`
+}
+
+func (catalogSupplyBook) TableName() string { return "supply_books" }
+
+// preferredSupplier 是版本 12 时供应商表上的首选标记
+type preferredSupplier struct {
+	Preferred bool `gorm:"not null;default:false"`
+}
+
+func (preferredSupplier) TableName() string { return "suppliers" }
+
+// sourcedPurchaseOrder 是版本 12 时采购单表上的供应商和单价列
+type sourcedPurchaseOrder struct {
+	SupplierID *int32 `gorm:"index"`
+	UnitCost   int32  `gorm:"not null;default:0"`
+}
+
+func (sourcedPurchaseOrder) TableName() string { return "purchase_orders" }
+
+// baselineSupplyBookUnique 是版本 1 创建的书号唯一约束
+const baselineSupplyBookUnique = "uni_supply_books_book_no"
+
+// addSupplierCatalogUp 允许多个供应商供应同一本书，为供应商添加首选标记，为采购单记录供应商和单价
+func addSupplierCatalogUp(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if migrator.HasConstraint(&baselineSupplyBook{}, baselineSupplyBookUnique) {
+		if err := migrator.DropConstraint(&baselineSupplyBook{}, baselineSupplyBookUnique); err != nil {
+			return err
+		}
+	}
+	if err := migrator.CreateIndex(&catalogSupplyBook{}, "idx_supply_book_supplier"); err != nil {
+		return err
+	}
+	if err := migrator.AddColumn(&preferredSupplier{}, "Preferred"); err != nil {
+		return err
+	}
+	if err := migrator.AddColumn(&sourcedPurchaseOrder{}, "SupplierID"); err != nil {
+		return err
+	}
+	if err := migrator.CreateIndex(&sourcedPurchaseOrder{}, "SupplierID"); err != nil {
+		return err
+	}
+	return migrator.AddColumn(&sourcedPurchaseOrder{}, "UnitCost")
+}
+
+// addSupplierCatalogDown 删除新增的列和索引，恢复书号唯一约束，多个供应商供应同一本书时撤销失败
+func addSupplierCatalogDown(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.DropIndex(&sourcedPurchaseOrder{}, "SupplierID"); err != nil {
+		return err
+	}
+	if err := migrator.DropColumn(&sourcedPurchaseOrder{}, "UnitCost"); err != nil {
+		return err
+	}
+	if err := migrator.DropColumn(&sourcedPurchaseOrder{}, "SupplierID"); err != nil {
+		return err
+	}
+	if err := migrator.DropColumn(&preferredSupplier{}, "Preferred"); err != nil {
+		return err
+	}
+	if err := migrator.DropIndex(&catalogSupplyBook{}, "idx_supply_book_supplier"); err != nil {
+		return err
+	}
+	return migrator.CreateConstraint(&baselineSupplyBook{}, baselineSupplyBookUnique)
+}
@@ -0,0 +1,128 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// purchaseOrderItem 是版本 13 时的采购单明细表结构
+type purchaseOrderItem struct {
+	ID               int32  `gorm:"primaryKey"`
+	PurchaseOrderID  int32  `gorm:"not null;uniqueIndex:idx_purchase_order_item,priority:1"`
+	BookNo           string `gorm:"not null;size:255;uniqueIndex:idx_purchase_order_item,priority:2;index"`
+	Title            string `gorm:"size:255;not null"`
+	Publisher        string `gorm:"size:255;not null"`
+	Author           string `gorm:"size:255;not null"`
+	Quantity         int32  `gorm:"not null"`
+	ReceivedQuantity int32  `gorm:"not null;default:0"`
+	UnitCost         int32  `gorm:"not null;default:0"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (purchaseOrderItem) TableName() string { return "purchase_order_items" }
+
+// legacyPurchaseOrderColumns 是拆分明细之前采购单表上每本书的列
+type legacyPurchaseOrderColumns struct {
+	BookNo           string `gorm:"not null;size:255;default:''"`
+	Title            string `gorm:"size:255;not null;default:''"`
+	Publisher        string `gorm:"size:255;not null;default:''"`
+	Author           string `gorm:"size:255;not null;default:''"`
+	Quantity         int32  `gorm:"not null;default:0"`
+	ReceivedQuantity int32  `gorm:"not null;default:0"`
+	UnitCost         int32  `gorm:"not null;default:0"`
+}
+
+func (legacyPurchaseOrderColumns) TableName() string { return "purchase_orders" }
+
+// legacyPurchaseOrderFields 是采购单表和明细表共有的列
+var legacyPurchaseOrderFields = []string{"BookNo", "Title", "Publisher", "Author", "Quantity", "ReceivedQuantity", "UnitCost"}
+
+// itemPurchaseOrderReceipt 是版本 13 时的到货表唯一索引，到货按书登记
+type itemPurchaseOrderReceipt struct {
+	PurchaseOrderID int32  `gorm:"not null;uniqueIndex:idx_purchase_order_receipt,priority:1"`
+	ReceiptID       string `gorm:"size:255;not null;uniqueIndex:idx_purchase_order_receipt,priority:2"`
+	BookNo          string `gorm:"not null;size:255;default:'';uniqueIndex:idx_purchase_order_receipt,priority:3"`
+}
+
+func (itemPurchaseOrderReceipt) TableName() string { return "purchase_order_receipts" }
+
+// splitPurchaseOrderItemsUp 把旧的单书采购单迁移为只有一行明细的采购单，到货记录补上书号，然后删除采购单表上每本书的列
+func splitPurchaseOrderItemsUp(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.CreateTable(&purchaseOrderItem{}); err != nil {
+		return err
+	}
+	if err := tx.Exec("INSERT INTO purchase_order_items " +
+		"(purchase_order_id, book_no, title, publisher, author, quantity, received_quantity, unit_cost, created_at, updated_at) " +
+		"SELECT id, book_no, title, publisher, author, quantity, received_quantity, unit_cost, created_at, updated_at FROM purchase_orders").Error; err != nil {
+		return err
+	}
+
+	if err := migrator.AddColumn(&itemPurchaseOrderReceipt{}, "BookNo"); err != nil {
+		return err
+	}
+	orderBookNo := tx.Table("purchase_orders").Select("book_no").Where("purchase_orders.id = purchase_order_receipts.purchase_order_id")
+	if err := tx.Table("purchase_order_receipts").Where("1 = 1").Update("book_no", orderBookNo).Error; err != nil {
+		return err
+	}
+	if err := migrator.DropIndex(&purchaseOrderReceipt{}, "idx_purchase_order_receipt"); err != nil {
+		return err
+	}
+	if err := migrator.CreateIndex(&itemPurchaseOrderReceipt{}, "idx_purchase_order_receipt"); err != nil {
+		return err
+	}
+
+	for _, column := range legacyPurchaseOrderFields {
+		if err := migrator.DropColumn(&legacyPurchaseOrderColumns{}, column); err != nil {
+			return err
+		}
+	}
+	// SQLite 删除列时会重建表，版本 12 创建的供应商索引需要补回
+	if !migrator.HasIndex(&sourcedPurchaseOrder{}, "SupplierID") {
+		return migrator.CreateIndex(&sourcedPurchaseOrder{}, "SupplierID")
+	}
+	return nil
+}
+
+// splitPurchaseOrderItemsDown 恢复采购单表上每本书的列，并用采购单的第一行明细回填，删除到货记录的书号和明细表
+func splitPurchaseOrderItemsDown(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	for _, column := range legacyPurchaseOrderFields {
+		if err := migrator.AddColumn(&legacyPurchaseOrderColumns{}, column); err != nil {
+			return err
+		}
+	}
+
+	// 采购单第一行明细中某一列的子查询
+	firstItem := func(column string) *gorm.DB {
+		return tx.Model(&purchaseOrderItem{}).
+			Select(column).
+			Where("purchase_order_items.purchase_order_id = purchase_orders.id").
+			Order("purchase_order_items.id").Limit(1)
+	}
+	updates := map[string]interface{}{}
+	for _, column := range []string{"book_no", "title", "publisher", "author", "quantity", "received_quantity", "unit_cost"} {
+		updates[column] = firstItem(column)
+	}
+	if err := tx.Table("purchase_orders").
+		Where("id IN (?)", tx.Model(&purchaseOrderItem{}).Select("purchase_order_id")).
+		Updates(updates).Error; err != nil {
+		return err
+	}
+
+	if err := migrator.DropIndex(&itemPurchaseOrderReceipt{}, "idx_purchase_order_receipt"); err != nil {
+		return err
+	}
+	if err := migrator.DropColumn(&itemPurchaseOrderReceipt{}, "BookNo"); err != nil {
+		return err
+	}
+	// SQLite 删除列时会重建表，旧的唯一索引可能已经随表删除
+	if !migrator.HasIndex(&purchaseOrderReceipt{}, "idx_purchase_order_receipt") {
+		if err := migrator.CreateIndex(&purchaseOrderReceipt{}, "idx_purchase_order_receipt"); err != nil {
+			return err
+		}
+	}
+	return migrator.DropTable(&purchaseOrderItem{})
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// orderedStockRequest 是版本 14 时缺书登记表上的采购单列
+type orderedStockRequest struct {
+	PurchaseOrderID *int32 `gorm:"index"`
+}
+
+func (orderedStockRequest) TableName() string { return "stock_requests" }
+
+// addStockRequestPurchaseOrdersUp 为缺书登记记录由它生成的采购单，已经完成的缺书登记无法追溯，保持为空
+func addStockRequestPurchaseOrdersUp(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.AddColumn(&orderedStockRequest{}, "PurchaseOrderID"); err != nil {
+		return err
+	}
+	return migrator.CreateIndex(&orderedStockRequest{}, "PurchaseOrderID")
+}
+
+// addStockRequestPurchaseOrdersDown 删除新增的列和索引
+func addStockRequestPurchaseOrdersDown(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.DropIndex(&orderedStockRequest{}, "PurchaseOrderID"); err != nil {
+		return err
+	}
+	if err := migrator.DropColumn(&orderedStockRequest{}, "PurchaseOrderID"); err != nil {
+		return err
+	}
+	// SQLite 删除列时会重建表，版本 8 创建的订单索引需要补回
+	if !migrator.HasIndex(&backorderStockRequest{}, "CustomerOrderID") {
+		return migrator.CreateIndex(&backorderStockRequest{}, "CustomerOrderID")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// reorderBook 是版本 15 时书籍表上的补货点和补货数量列
+type reorderBook struct {
+	ReorderPoint    int32 `gorm:"not null;default:0"`
+	ReorderQuantity int32 `gorm:"not null;default:0"`
+}
+
+func (reorderBook) TableName() string { return "books" }
+
+// addBookReorderPointsUp 为书籍添加补货点和补货数量，已有的书籍不自动补货
+func addBookReorderPointsUp(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.AddColumn(&reorderBook{}, "ReorderPoint"); err != nil {
+		return err
+	}
+	return migrator.AddColumn(&reorderBook{}, "ReorderQuantity")
+}
+
+// addBookReorderPointsDown 删除新增的列
+func addBookReorderPointsDown(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.DropColumn(&reorderBook{}, "ReorderQuantity"); err != nil {
+		return err
+	}
+	return migrator.DropColumn(&reorderBook{}, "ReorderPoint")
+}
@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// 以下是版本 16 时的已付款状态和旧版本下单时使用的折扣，之后定义改变时不能修改这里。
+
+// paidOrderStatuses 是版本 16 时已经付款的订单状态，版本 4 之后订单只使用这些标准状态
+var paidOrderStatuses = []string{"paid", "shipped", "delivered", "returned", "backordered", "ready"}
+
+// legacyOrderDiscounts 是旧版本下单时按信用等级给出的折扣百分比
+var legacyOrderDiscounts = []int32{0, 10, 15, 15, 20, 25}
+
+// backfillOrderPaidAmountsUp 为引入实付金额之前的已付款订单回填实付金额。
+// 旧订单在创建时按客户信用等级的折扣价扣款，折扣价为 price * (100 - 折扣) / 100，
+// 实付金额为 0 时取消订单不会退款。找不到客户或者信用等级无法识别的订单保持为 0，取消时不退款
+func backfillOrderPaidAmountsUp(tx *gorm.DB) error {
+	var orders []struct {
+		ID          int32
+		Price       int32
+		CreditLevel int32
+	}
+	err := tx.Table("customer_orders").
+		Select("customer_orders.id, customer_orders.price, customers.credit_level").
+		Joins("JOIN customers ON customers.online_id = customer_orders.customer_online_id").
+		Where("customer_orders.paid_amount = 0 AND customer_orders.price > 0 AND customer_orders.status IN ?", paidOrderStatuses).
+		Scan(&orders).Error
+	if err != nil {
+		return err
+	}
+
+	// 在 Go 中计算折扣价，与旧版本的整数除法保持一致
+	for _, order := range orders {
+		if order.CreditLevel < 0 || int(order.CreditLevel) >= len(legacyOrderDiscounts) {
+			continue
+		}
+		paidAmount := order.Price * (100 - legacyOrderDiscounts[order.CreditLevel]) / 100
+		if err := tx.Model(&baselineCustomerOrder{}).Where("id = ?", order.ID).Update("paid_amount", paidAmount).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillOrderPaidAmountsDown 回填只补充了数据，撤销时无须处理
+func backfillOrderPaidAmountsDown(tx *gorm.DB) error {
+	return nil
+}
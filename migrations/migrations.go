@@ -0,0 +1,183 @@
+// Package migrations 管理数据库结构的版本，每次结构变更都作为一个带版本号的迁移发布，
+// 已执行的迁移记录在 schema_migrations 表中。
+package migrations
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migration 是一次结构变更，Up 执行变更，Down 撤销变更
+type Migration struct {
+	// 版本号，按从小到大的顺序执行，发布后不能修改
+	Version int64
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// SchemaMigration 记录已执行的迁移
+type SchemaMigration struct {
+	Version   int64  `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"size:255;not null"`
+	AppliedAt time.Time
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// MigrationStatus 是一个迁移的执行状态
+type MigrationStatus struct {
+	Version int64
+	Name    string
+	Applied bool
+	// 未执行时为零值
+	AppliedAt time.Time
+}
+
+// migrations 是所有迁移，按版本号排列，新的迁移追加在末尾
+var migrations = []Migration{
+	{Version: 1, Name: "baseline", Up: baselineUp, Down: baselineDown},
+	{Version: 2, Name: "backfill_publishers", Up: backfillPublishersUp, Down: backfillPublishersDown},
+	{Version: 3, Name: "split_order_items", Up: splitOrderItemsUp, Down: splitOrderItemsDown},
+	{Version: 4, Name: "normalize_order_statuses", Up: normalizeOrderStatusesUp, Down: normalizeOrderStatusesDown},
+	{Version: 5, Name: "create_notification_outbox", Up: createNotificationOutboxUp, Down: createNotificationOutboxDown},
+	{Version: 6, Name: "add_customer_contacts", Up: addCustomerContactsUp, Down: addCustomerContactsDown},
+	{Version: 7, Name: "create_stock_waitlists", Up: createStockWaitlistsUp, Down: createStockWaitlistsDown},
+	{Version: 8, Name: "add_backorders", Up: addBackordersUp, Down: addBackordersDown},
+	{Version: 9, Name: "create_stock_reservations", Up: createStockReservationsUp, Down: createStockReservationsDown},
+	{Version: 10, Name: "create_stock_movements", Up: createStockMovementsUp, Down: createStockMovementsDown},
+	{Version: 11, Name: "add_purchase_order_receipts", Up: addPurchaseOrderReceiptsUp, Down: addPurchaseOrderReceiptsDown},
+	{Version: 12, Name: "add_supplier_catalog", Up: addSupplierCatalogUp, Down: addSupplierCatalogDown},
+	{Version: 13, Name: "split_purchase_order_items", Up: splitPurchaseOrderItemsUp, Down: splitPurchaseOrderItemsDown},
+	{Version: 14, Name: "add_stock_request_purchase_orders", Up: addStockRequestPurchaseOrdersUp, Down: addStockRequestPurchaseOrdersDown},
+	{Version: 15, Name: "add_book_reorder_points", Up: addBookReorderPointsUp, Down: addBookReorderPointsDown},
+	{Version: 16, Name: "backfill_order_paid_amounts", Up: backfillOrderPaidAmountsUp, Down: backfillOrderPaidAmountsDown},
+}
+
+// Up 按顺序执行所有尚未执行的迁移
+func Up(db *gorm.DB) error {
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return err
+	}
+	if err := checkKnownVersions(applied); err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		err := run(db, func(tx *gorm.DB) error {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("迁移 %d_%s 执行失败: %w", migration.Version, migration.Name, err)
+		}
+	}
+	return nil
+}
+
+// Down 按从新到旧的顺序撤销最近执行的 steps 个迁移
+func Down(db *gorm.DB, steps int) error {
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return err
+	}
+	if err := checkKnownVersions(applied); err != nil {
+		return err
+	}
+
+	for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		err := run(db, func(tx *gorm.DB) error {
+			if err := migration.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return fmt.Errorf("迁移 %d_%s 撤销失败: %w", migration.Version, migration.Name, err)
+		}
+		steps--
+	}
+	return nil
+}
+
+// Status 返回所有迁移的执行状态
+func Status(db *gorm.DB) ([]MigrationStatus, error) {
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MigrationStatus
+	for _, migration := range migrations {
+		record, ok := applied[migration.Version]
+		statuses = append(statuses, MigrationStatus{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			Applied:   ok,
+			AppliedAt: record.AppliedAt,
+		})
+	}
+	return statuses, checkKnownVersions(applied)
+}
+
+// appliedVersions 查询已执行的迁移，schema_migrations 表不存在时先创建
+func appliedVersions(db *gorm.DB) (map[int64]SchemaMigration, error) {
+	if err := db.AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, err
+	}
+	var records []SchemaMigration
+	if err := db.Find(&records).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// checkKnownVersions 检查数据库中是否有本程序不认识的迁移，说明数据库已被更新的版本迁移过
+func checkKnownVersions(applied map[int64]SchemaMigration) error {
+	known := make(map[int64]bool, len(migrations))
+	for _, migration := range migrations {
+		known[migration.Version] = true
+	}
+	var unknown []int64
+	for version := range applied {
+		if !known[version] {
+			unknown = append(unknown, version)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+	return fmt.Errorf("数据库中有未知的迁移版本 %v，请使用更新版本的程序", unknown)
+}
+
+// run 在支持事务性 DDL 的数据库上用事务执行迁移，MySQL 的 DDL 会隐式提交事务，只能直接执行
+func run(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if db.Dialector.Name() == "mysql" {
+		return fn(db)
+	}
+	return db.Transaction(fn)
+}
@@ -0,0 +1,268 @@
+package migrations
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	return db
+}
+
+// legacyBook 模拟引入出版社之前的书籍表结构
+type legacyBook struct {
+	ID            int32 `gorm:"primaryKey"`
+	BookNo        string
+	Title         string
+	PublisherName string
+}
+
+func (legacyBook) TableName() string {
+	return "books"
+}
+
+// legacyCustomerOrder 模拟拆分明细之前的订单表结构
+type legacyCustomerOrder struct {
+	ID               int32 `gorm:"primaryKey"`
+	OrderDate        string
+	CustomerOnlineID string
+	BookNo           string
+	BookCount        int32
+	Price            int32
+	Address          string
+	Status           string
+}
+
+func (legacyCustomerOrder) TableName() string {
+	return "customer_orders"
+}
+
+func TestUpDownStatus(t *testing.T) {
+	db := setupTestDB(t)
+
+	// 全部执行
+	assert.NoError(t, Up(db))
+	statuses, err := Status(db)
+	assert.NoError(t, err)
+	assert.Equal(t, len(migrations), len(statuses))
+	for _, status := range statuses {
+		assert.True(t, status.Applied, status.Name)
+		assert.False(t, status.AppliedAt.IsZero())
+	}
+	assert.True(t, db.Migrator().HasTable(&models.Book{}))
+
+	// 再次执行不会重复迁移
+	assert.NoError(t, Up(db))
+	var count int64
+	db.Model(&SchemaMigration{}).Count(&count)
+	assert.Equal(t, int64(len(migrations)), count)
+
+	// 撤销最近一个迁移
+	assert.NoError(t, Down(db, 1))
+	statuses, err = Status(db)
+	assert.NoError(t, err)
+	assert.False(t, statuses[len(statuses)-1].Applied)
+	assert.True(t, statuses[len(statuses)-2].Applied)
+
+	// 全部撤销后表被删除，可以重新迁移
+	assert.NoError(t, Down(db, len(migrations)))
+	assert.False(t, db.Migrator().HasTable(&models.Book{}))
+	db.Model(&SchemaMigration{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+	assert.NoError(t, Up(db))
+	assert.True(t, db.Migrator().HasTable(&models.Book{}))
+
+	// 数据库中有未知的迁移版本
+	db.Create(&SchemaMigration{Version: 9999, Name: "future"})
+	assert.ErrorContains(t, Up(db), "9999")
+	_, err = Status(db)
+	assert.ErrorContains(t, err, "9999")
+}
+
+func TestBackfillPublishers(t *testing.T) {
+	db := setupTestDB(t)
+
+	// 模拟旧数据：只有 publisher_name，没有 publisher_id
+	assert.NoError(t, db.AutoMigrate(&legacyBook{}))
+	db.Create(&legacyBook{BookNo: "B001", Title: "Book 1", PublisherName: "Old Press"})
+	db.Create(&legacyBook{BookNo: "B002", Title: "Book 2", PublisherName: "Old Press"})
+	db.Create(&legacyBook{BookNo: "B003", Title: "Book 3", PublisherName: "Another Press"})
+
+	assert.NoError(t, Up(db))
+
+	var publishers []models.Publisher
+	db.Order("name").Find(&publishers)
+	assert.Equal(t, 2, len(publishers))
+
+	var books []models.Book
+	db.Preload("Publisher").Order("book_no").Find(&books)
+	for _, book := range books {
+		assert.NotNil(t, book.PublisherID)
+		assert.Equal(t, book.PublisherName, book.Publisher.Name)
+	}
+	assert.Equal(t, *books[0].PublisherID, *books[1].PublisherID)
+}
+
+func TestSplitOrderItems(t *testing.T) {
+	db := setupTestDB(t)
+
+	// 模拟旧数据：每个订单只有一本书
+	assert.NoError(t, db.AutoMigrate(&legacyCustomerOrder{}))
+	db.Create(&legacyCustomerOrder{OrderDate: "2023-01-01", CustomerOnlineID: "customer1", BookNo: "B001", BookCount: 2, Price: 100, Address: "Address 1", Status: "未发货"})
+	db.Create(&legacyCustomerOrder{OrderDate: "2023-01-02", CustomerOnlineID: "customer2", BookNo: "B002", BookCount: 1, Price: 60, Address: "Address 2", Status: "未发货"})
+
+	assert.NoError(t, Up(db))
+	assert.False(t, db.Migrator().HasColumn(&models.CustomerOrder{}, "book_no"))
+
+	var orders []models.CustomerOrder
+	db.Preload("Items").Order("id").Find(&orders)
+	assert.Equal(t, 2, len(orders))
+	assert.Equal(t, 1, len(orders[0].Items))
+	assert.Equal(t, "B001", orders[0].Items[0].BookNo)
+	assert.Equal(t, int32(2), orders[0].Items[0].BookCount)
+	assert.Equal(t, int32(100), orders[0].Items[0].Price)
+	assert.Equal(t, "B002", orders[1].Items[0].BookNo)
+	assert.Equal(t, models.OrderStatusPaid, orders[0].Status)
+
+	// 撤销到版本 2 后恢复旧的书号和数量列
+	assert.NoError(t, Down(db, len(migrations)-2))
+	var legacyOrders []legacyCustomerOrder
+	db.Order("id").Find(&legacyOrders)
+	assert.Equal(t, "B001", legacyOrders[0].BookNo)
+	assert.Equal(t, int32(2), legacyOrders[0].BookCount)
+	assert.Equal(t, "B002", legacyOrders[1].BookNo)
+
+	// 再次迁移不会重复创建明细
+	assert.NoError(t, Up(db))
+	var itemCount int64
+	db.Model(&models.CustomerOrderItem{}).Count(&itemCount)
+	assert.Equal(t, int64(2), itemCount)
+}
+
+func TestBackfillOrderPaidAmounts(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, Up(db))
+
+	// 撤销到版本 15，模拟引入实付金额之前的订单
+	assert.NoError(t, Down(db, len(migrations)-15))
+	for _, customer := range []*models.Customer{
+		{OnlineID: "customer0", Name: "Customer 0", Address: "Address 0", CreditLevel: 0},
+		{OnlineID: "customer2", Name: "Customer 2", Address: "Address 2", CreditLevel: 2},
+		{OnlineID: "customer5", Name: "Customer 5", Address: "Address 5", CreditLevel: 5},
+	} {
+		db.Create(customer)
+	}
+	order := func(onlineID string, price, paidAmount int32, orderStatus models.OrderStatus) int32 {
+		customerOrder := &models.CustomerOrder{OrderDate: "2023-01-01", CustomerOnlineID: onlineID, Price: price, PaidAmount: paidAmount, Address: "Address", Status: orderStatus}
+		db.Create(customerOrder)
+		return customerOrder.ID
+	}
+	level0 := order("customer0", 100, 0, models.OrderStatusPaid)
+	level2 := order("customer2", 135, 0, models.OrderStatusShipped)
+	level5 := order("customer5", 200, 0, models.OrderStatusDelivered)
+	cancelled := order("customer2", 80, 0, models.OrderStatusCancelled)
+	pending := order("customer2", 50, 0, models.OrderStatusPending)
+	recorded := order("customer2", 100, 90, models.OrderStatusPaid)
+	unknown := order("customer404", 70, 0, models.OrderStatusPaid)
+
+	assert.NoError(t, Up(db))
+	paidAmount := func(id int32) int32 {
+		var customerOrder models.CustomerOrder
+		db.First(&customerOrder, id)
+		return customerOrder.PaidAmount
+	}
+	// 已付款的旧订单按客户信用等级的折扣价回填，与下单时一样向下取整
+	assert.Equal(t, int32(100), paidAmount(level0))
+	assert.Equal(t, int32(114), paidAmount(level2))
+	assert.Equal(t, int32(150), paidAmount(level5))
+	// 未付款、已取消和已经记录实付金额的订单不回填，找不到客户的订单保持为 0
+	assert.Equal(t, int32(0), paidAmount(cancelled))
+	assert.Equal(t, int32(0), paidAmount(pending))
+	assert.Equal(t, int32(90), paidAmount(recorded))
+	assert.Equal(t, int32(0), paidAmount(unknown))
+}
+
+func TestCreateStockMovements(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, Up(db))
+
+	// 撤销到版本 9，模拟引入库存变动之前已有库存的书籍
+	assert.NoError(t, Down(db, len(migrations)-9))
+	db.Create(&baselineBook{BookNo: "B001", Title: "Book 1", StockQuantity: 5})
+	db.Create(&baselineBook{BookNo: "B002", Title: "Book 2"})
+
+	// 已有的库存写入期初调整
+	assert.NoError(t, Up(db))
+	var movements []models.StockMovement
+	db.Order("id").Find(&movements)
+	assert.Equal(t, 1, len(movements))
+	assert.Equal(t, "B001", movements[0].BookNo)
+	assert.Equal(t, int32(5), movements[0].Delta)
+	assert.Equal(t, models.StockMovementAdjustment, movements[0].Reason)
+}
+
+func TestAddPurchaseOrderReceipts(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, Up(db))
+
+	// 撤销到版本 10，模拟已经完成和尚未完成的采购单
+	assert.NoError(t, Down(db, len(migrations)-10))
+	assert.NoError(t, db.Create(&baselinePurchaseOrder{BookNo: "B001", Title: "Book 1", Publisher: "Publisher", Supplier: "Supplier", Author: "Author", Quantity: 5, OrderDate: "2023-01-01", Finished: true}).Error)
+	assert.NoError(t, db.Create(&baselinePurchaseOrder{BookNo: "B002", Title: "Book 2", Publisher: "Publisher", Supplier: "Supplier", Author: "Author", Quantity: 3, OrderDate: "2023-01-01"}).Error)
+
+	// 已经完成的采购单视为全部到货
+	assert.NoError(t, Up(db))
+	var items []models.PurchaseOrderItem
+	db.Order("purchase_order_id").Find(&items)
+	assert.Equal(t, int32(5), items[0].ReceivedQuantity)
+	assert.Equal(t, int32(0), items[1].ReceivedQuantity)
+}
+
+func TestSplitPurchaseOrderItems(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, Up(db))
+
+	// 撤销到版本 12，模拟旧的单书采购单和它的到货记录
+	assert.NoError(t, Down(db, len(migrations)-12))
+	order := &baselinePurchaseOrder{BookNo: "B001", Title: "Book 1", Publisher: "Publisher", Supplier: "Supplier", Author: "Author", Quantity: 5, OrderDate: "2023-01-01"}
+	assert.NoError(t, db.Create(order).Error)
+	assert.NoError(t, db.Table("purchase_orders").Where("id = ?", order.ID).Updates(map[string]interface{}{"received_quantity": 2, "unit_cost": 30}).Error)
+	assert.NoError(t, db.Create(&purchaseOrderReceipt{PurchaseOrderID: order.ID, ReceiptID: "R1", Quantity: 2, Actor: "clerk"}).Error)
+
+	// 每个采购单迁移为一行明细，到货记录补上书号
+	assert.NoError(t, Up(db))
+	assert.False(t, db.Migrator().HasColumn(&models.PurchaseOrder{}, "book_no"))
+	var purchaseOrder models.PurchaseOrder
+	assert.NoError(t, db.Preload("Items").Preload("Receipts").First(&purchaseOrder, order.ID).Error)
+	assert.Equal(t, "Supplier", purchaseOrder.Supplier)
+	assert.Equal(t, 1, len(purchaseOrder.Items))
+	assert.Equal(t, "B001", purchaseOrder.Items[0].BookNo)
+	assert.Equal(t, int32(5), purchaseOrder.Items[0].Quantity)
+	assert.Equal(t, int32(2), purchaseOrder.Items[0].ReceivedQuantity)
+	assert.Equal(t, int32(30), purchaseOrder.Items[0].UnitCost)
+	assert.Equal(t, "B001", purchaseOrder.Receipts[0].BookNo)
+
+	// 同一个到货单号可以登记不同的书
+	assert.NoError(t, db.Create(&models.PurchaseOrderItem{PurchaseOrderID: order.ID, BookNo: "B002", Title: "Book 2", Publisher: "Publisher", Author: "Author", Quantity: 1}).Error)
+	assert.NoError(t, db.Create(&models.PurchaseOrderReceipt{PurchaseOrderID: order.ID, ReceiptID: "R1", BookNo: "B002", Quantity: 1, Actor: "clerk"}).Error)
+	assert.Error(t, db.Create(&models.PurchaseOrderReceipt{PurchaseOrderID: order.ID, ReceiptID: "R1", BookNo: "B002", Quantity: 1, Actor: "clerk"}).Error)
+	db.Where("book_no = ?", "B002").Delete(&models.PurchaseOrderReceipt{})
+
+	// 撤销到版本 12 后用第一行明细回填采购单
+	assert.NoError(t, Down(db, len(migrations)-12))
+	var legacyOrder baselinePurchaseOrder
+	assert.NoError(t, db.First(&legacyOrder, order.ID).Error)
+	assert.Equal(t, "B001", legacyOrder.BookNo)
+	assert.Equal(t, int32(5), legacyOrder.Quantity)
+	assert.False(t, db.Migrator().HasTable("purchase_order_items"))
+	assert.NoError(t, Up(db))
+}
@@ -1,50 +1,188 @@
 package models
 
 import (
+	"strings"
 	"time"
-
-	"gorm.io/gorm"
 )
 
 // 书籍
 // 如果有多个关键字或者作者，那么用逗号连接他们
 type Book struct {
-	ID            int32  `gorm:"primaryKey"`
-	BookNo        string `gorm:"unique;not null;size:255"`
-	Title         string `gorm:"size:255;not null"`
-	PublisherName string `gorm:"size:255"`
-	Price         int32  `gorm:"not null;default:0"`
-	Keywords      string `gorm:"size:1024"`
-	Authors       string `gorm:"size:1024"`
-	StockQuantity int32
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID     int32  `gorm:"primaryKey"`
+	BookNo string `gorm:"unique;not null;size:255"`
+	Title  string `gorm:"size:255;not null"`
+	// 出版社名称，与 Publisher.Name 保持一致，便于检索
+	PublisherName string     `gorm:"size:255"`
+	PublisherID   *int32     `gorm:"index"`
+	Publisher     *Publisher `gorm:"foreignKey:PublisherID"`
+	// 所属丛书，可以为空
+	SeriesID *int32  `gorm:"index"`
+	Series   *Series `gorm:"foreignKey:SeriesID"`
+	Price    int32   `gorm:"not null;default:0"`
+	Keywords string  `gorm:"size:1024"`
+	Authors  string  `gorm:"size:1024"`
+	// 在库数量，包括被客户预留、尚未付款的数量
+	StockQuantity    int32
+	ReservedQuantity int32 `gorm:"not null;default:0"`
+	// 可以下单的数量低于补货点时自动创建缺书登记，补货点为 0 时不自动补货
+	ReorderPoint int32 `gorm:"not null;default:0"`
+	// 每次补货的数量，为 0 时补足到补货点
+	ReorderQuantity int32 `gorm:"not null;default:0"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// AvailableQuantity 返回可以下单的数量，即没有被预留的在库数量
+func (b *Book) AvailableQuantity() int32 {
+	return b.StockQuantity - b.ReservedQuantity
+}
+
+// 出版社
+type Publisher struct {
+	ID          int32  `gorm:"primaryKey"`
+	Name        string `gorm:"unique;not null;size:255"`
+	ContactInfo string `gorm:"size:1024"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// 丛书
+type Series struct {
+	ID          int32  `gorm:"primaryKey"`
+	Name        string `gorm:"unique;not null;size:255"`
+	Description string `gorm:"size:1024"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 // 客户
 type Customer struct {
-	ID             int32            `gorm:"primaryKey"`
-	OnlineID       string           `gorm:"unique;not null;size:255"`
-	Password       string           `gorm:"size:255;not null"`
-	Name           string           `gorm:"size:255;not null"`
-	Address        string           `gorm:"size:512;not null"`
-	AccountBalance int32            `gorm:"not null;default:0"`
-	CreditLevel    int32            `gorm:"not null"`
-	CustomerOrders []*CustomerOrder `gorm:"foreignKey:CustomerOnlineID;references:OnlineID"`
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID       int32  `gorm:"primaryKey"`
+	OnlineID string `gorm:"unique;not null;size:255"`
+	// bcrypt 哈希，旧数据可能是明文，会在第一次登录成功后改为哈希
+	Password string `gorm:"size:255;not null"`
+	// 角色：customer、clerk、purchasing 或 admin
+	Role           string `gorm:"size:50;not null;default:'customer'"`
+	Name           string `gorm:"size:255;not null"`
+	Address        string `gorm:"size:512;not null"`
+	AccountBalance int32  `gorm:"not null;default:0"`
+	CreditLevel    int32  `gorm:"not null"`
+	Email          string `gorm:"size:255"`
+	Phone          string `gorm:"size:50"`
+	// 通知偏好，为空时通过邮件接收所有通知
+	NotificationPreferences *NotificationPreferences `gorm:"serializer:json;type:text"`
+	CustomerOrders          []*CustomerOrder         `gorm:"foreignKey:CustomerOnlineID;references:OnlineID"`
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
 }
 
+// 通知渠道
+const (
+	NotificationChannelEmail = "email"
+	NotificationChannelSMS   = "sms"
+)
+
+// 客户的通知偏好，以 JSON 保存在客户表中
+type NotificationPreferences struct {
+	// 接收通知的渠道，为空时不接收任何通知
+	Channels []string `json:"channels"`
+	// 按事件设置是否接收，未列出的事件默认接收
+	Events map[string]bool `json:"events,omitempty"`
+}
+
+// NotificationChannels 返回客户接收 event 通知的渠道
+func (c *Customer) NotificationChannels(event string) []string {
+	preferences := c.NotificationPreferences
+	if preferences == nil {
+		return []string{NotificationChannelEmail}
+	}
+	if enabled, ok := preferences.Events[event]; ok && !enabled {
+		return nil
+	}
+	return preferences.Channels
+}
+
+// 客户订单，每本书对应一行 CustomerOrderItem
 type CustomerOrder struct {
 	ID        int32  `gorm:"primaryKey"`
 	OrderDate string `gorm:"not null;size:50"`
 	// 客户的在线ID
 	CustomerOnlineID string `gorm:"size:255"`
-	BookNo           string `gorm:"not null;size:255"`
-	BookCount        int32  `gorm:"not null"`
-	Price            int32  `gorm:"not null;default:0"`
-	Address          string `gorm:"size:512;not null"`
-	Status           string `gorm:"not null;size:50;default:'未发货'"`
+	// 订单总价（折扣前），等于各行价格之和
+	Price int32 `gorm:"not null;default:0"`
+	// 实际扣款金额（折扣后）
+	PaidAmount int32 `gorm:"not null;default:0"`
+	// 取消订单时退还的金额
+	RefundAmount int32                `gorm:"not null;default:0"`
+	Address      string               `gorm:"size:512;not null"`
+	Status       OrderStatus          `gorm:"not null;size:50;default:'paid'"`
+	Items        []*CustomerOrderItem `gorm:"foreignKey:OrderID"`
+	// 状态变更记录，按时间先后排列
+	StatusHistory []*CustomerOrderStatusTransition `gorm:"foreignKey:OrderID"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// 订单状态
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusPaid      OrderStatus = "paid"
+	OrderStatusShipped   OrderStatus = "shipped"
+	OrderStatusDelivered OrderStatus = "delivered"
+	OrderStatusCancelled OrderStatus = "cancelled"
+	OrderStatusReturned  OrderStatus = "returned"
+	// 部分书目缺货，已付款，缺货的书到货后变为 ready
+	OrderStatusBackordered OrderStatus = "backordered"
+	OrderStatusReady       OrderStatus = "ready"
+)
+
+// 旧版本使用的中文状态
+var legacyOrderStatuses = map[string]OrderStatus{
+	"未发货": OrderStatusPaid,
+	"已发货": OrderStatusShipped,
+	"已送达": OrderStatusDelivered,
+	"已收货": OrderStatusDelivered,
+	"已取消": OrderStatusCancelled,
+	"已退货": OrderStatusReturned,
+}
+
+// ParseOrderStatus 解析订单状态，兼容大小写不同的英文状态和旧版本的中文状态
+func ParseOrderStatus(value string) (OrderStatus, bool) {
+	if status, ok := legacyOrderStatuses[value]; ok {
+		return status, true
+	}
+	status := OrderStatus(strings.ToLower(strings.TrimSpace(value)))
+	switch status {
+	case OrderStatusPending, OrderStatusPaid, OrderStatusShipped,
+		OrderStatusDelivered, OrderStatusCancelled, OrderStatusReturned,
+		OrderStatusBackordered, OrderStatusReady:
+		return status, true
+	}
+	return "", false
+}
+
+// 订单状态变更记录
+type CustomerOrderStatusTransition struct {
+	ID      int32 `gorm:"primaryKey"`
+	OrderID int32 `gorm:"not null;index"`
+	// 订单刚创建时为空
+	FromStatus OrderStatus `gorm:"size:50"`
+	ToStatus   OrderStatus `gorm:"not null;size:50"`
+	CreatedAt  time.Time
+}
+
+// 客户订单明细
+type CustomerOrderItem struct {
+	ID        int32  `gorm:"primaryKey"`
+	OrderID   int32  `gorm:"not null;index"`
+	BookNo    string `gorm:"not null;size:255;index"`
+	BookCount int32  `gorm:"not null"`
+	// 该行的总价（折扣前）
+	Price int32 `gorm:"not null;default:0"`
+	// 尚未到货的数量，下单时没有扣除这部分库存
+	BackorderedCount int32 `gorm:"not null;default:0"`
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
@@ -60,35 +198,159 @@ type StockRequest struct {
 	Author      string `gorm:"size:255"`
 	Supplier    string `gorm:"size:255"`
 	Finished    bool   `gorm:"not null;default:false"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// 缺货订单创建的缺书登记对应的订单
+	CustomerOrderID *int32 `gorm:"index"`
+	// 由这条缺书登记生成的采购单
+	PurchaseOrderID *int32 `gorm:"index"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// 库存不足时等待到货的客户，采购单到货后按登记的先后顺序处理：
+// 缺货订单的登记为订单保留库存，其他登记自动下单或通知客户
+type StockWaitlist struct {
+	ID               int32  `gorm:"primaryKey"`
+	CustomerOnlineID string `gorm:"size:255;not null;index"`
+	BookNo           string `gorm:"size:255;not null;index:idx_stock_waitlist_due,priority:1"`
+	// 客户需要的数量，订购时这本书的总价和收货地址
+	Quantity int32  `gorm:"not null"`
+	Price    int32  `gorm:"not null"`
+	Address  string `gorm:"size:512;not null"`
+	// 客户下单时同意到货后自动下单扣款，否则只通知客户
+	AutoFulfill bool `gorm:"not null;default:false"`
+	// 库存不足时创建的缺书登记
+	StockRequestID int32 `gorm:"index"`
+	// 状态：waiting、fulfilled、notified 或 cancelled
+	Status string `gorm:"size:20;not null;index:idx_stock_waitlist_due,priority:2"`
+	// 缺货订单，或者到货后自动创建的客户订单
+	CustomerOrderID *int32
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// 等待到货登记的状态
+const (
+	WaitlistWaiting   = "waiting"
+	WaitlistFulfilled = "fulfilled"
+	WaitlistNotified  = "notified"
+	WaitlistCancelled = "cancelled"
+)
+
+// 客户结账前预留的库存，过期前没有确认时释放
+type StockReservation struct {
+	ID               int32  `gorm:"primaryKey"`
+	CustomerOnlineID string `gorm:"size:255;not null;index"`
+	// 状态：active、confirmed、released 或 expired
+	Status    string    `gorm:"size:20;not null;index:idx_stock_reservation_due,priority:1"`
+	ExpiresAt time.Time `gorm:"not null;index:idx_stock_reservation_due,priority:2"`
+	// 确认后创建的客户订单
+	CustomerOrderID *int32
+	Items           []*StockReservationItem `gorm:"foreignKey:ReservationID"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// 预留的一本书
+type StockReservationItem struct {
+	ID            int32  `gorm:"primaryKey"`
+	ReservationID int32  `gorm:"not null;index"`
+	BookNo        string `gorm:"not null;size:255"`
+	Quantity      int32  `gorm:"not null"`
+	// 预留时的总价，确认时按这个价格扣款
+	Price     int32 `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// 预留的状态
+const (
+	ReservationActive    = "active"
+	ReservationConfirmed = "confirmed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+)
+
+// 库存变动记录，只追加不修改，每次修改在库数量时在同一个事务中写入
+type StockMovement struct {
+	ID     int32  `gorm:"primaryKey"`
+	BookNo string `gorm:"size:255;not null;index:idx_stock_movement_book,priority:1"`
+	// 变动数量，入库为正，出库为负
+	Delta int32 `gorm:"not null"`
+	// 原因：sale、receipt、adjustment 或 return
+	Reason string `gorm:"size:20;not null"`
+	// 关联的单据：销售和退货为客户订单，到货为采购单，调整为 0
+	ReferenceID int32 `gorm:"not null;default:0"`
+	// 操作者的在线ID，没有经过认证的内部调用为 system
+	Actor     string    `gorm:"size:255;not null"`
+	CreatedAt time.Time `gorm:"index:idx_stock_movement_book,priority:2"`
 }
 
+// 库存变动的原因
+const (
+	StockMovementSale       = "sale"
+	StockMovementReceipt    = "receipt"
+	StockMovementAdjustment = "adjustment"
+	StockMovementReturn     = "return"
+)
+
 // 采购单
 type PurchaseOrder struct {
 	ID        int32  `gorm:"primaryKey"`
-	BookNo    string `gorm:"not null;size:255"`
-	Title     string `gorm:"size:255;not null"`
-	Publisher string `gorm:"size:255;not null"`
 	Supplier  string `gorm:"not null;size:255"`
-	Author    string `gorm:"size:255;not null"`
-	Quantity  int32  `gorm:"not null"`
 	OrderDate string `gorm:"not null;size:50"`
-	Finished  bool   `gorm:"not null;default:false"`
+	// 全部到货或者提前结束后为 true，不再接收到货
+	Finished bool `gorm:"not null;default:false"`
+	// 采购单由多行组成，每行一种书
+	Items    []*PurchaseOrderItem    `gorm:"foreignKey:PurchaseOrderID"`
+	Receipts []*PurchaseOrderReceipt `gorm:"foreignKey:PurchaseOrderID"`
+	// 从供书目录中选择的供应商，手动创建的采购单没有
+	SupplierID *int32 `gorm:"index"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// 采购单的一行，同一采购单中每种书只有一行
+type PurchaseOrderItem struct {
+	ID              int32  `gorm:"primaryKey"`
+	PurchaseOrderID int32  `gorm:"not null;uniqueIndex:idx_purchase_order_item,priority:1"`
+	BookNo          string `gorm:"not null;size:255;uniqueIndex:idx_purchase_order_item,priority:2;index"`
+	Title           string `gorm:"size:255;not null"`
+	Publisher       string `gorm:"size:255;not null"`
+	Author          string `gorm:"size:255;not null"`
+	Quantity        int32  `gorm:"not null"`
+	// 已经到货的数量，供应商多发或少发时与 Quantity 不同
+	ReceivedQuantity int32 `gorm:"not null;default:0"`
+	// 从供书目录中选择的单价，手动创建的采购单为 0
+	UnitCost  int32 `gorm:"not null;default:0"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// 采购单中一种书的一次到货，同一采购单同一种书的 ReceiptID 不能重复，重复提交的到货只记录一次
+type PurchaseOrderReceipt struct {
+	ID              int32  `gorm:"primaryKey"`
+	PurchaseOrderID int32  `gorm:"not null;uniqueIndex:idx_purchase_order_receipt,priority:1"`
+	ReceiptID       string `gorm:"size:255;not null;uniqueIndex:idx_purchase_order_receipt,priority:2"`
+	// 到货的书，同一个到货单可以包含采购单中的多种书
+	BookNo   string `gorm:"not null;size:255;uniqueIndex:idx_purchase_order_receipt,priority:3"`
+	Quantity int32  `gorm:"not null"`
+	// 登记到货的操作者
+	Actor     string `gorm:"size:255;not null"`
+	CreatedAt time.Time
+}
+
+// 供应商的供书目录，同一本书可以由多个供应商供货，每个供应商只有一条记录
 type SupplyBook struct {
 	ID            int32  `gorm:"primaryKey"`
-	BookNo        string `gorm:"unique;not null;size:255"`
+	BookNo        string `gorm:"not null;size:255;uniqueIndex:idx_supply_book_supplier,priority:2"`
 	Title         string `gorm:"size:255;not null"`
 	PublisherName string `gorm:"size:255"`
-	Price         int32  `gorm:"not null;default:0"`
-	Quantity      int32  `gorm:"not null;default:0"`
-	SupplierID    int32  `gorm:"not null;default:0"`
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	// 单价和供应商可以供货的数量
+	Price      int32 `gorm:"not null;default:0"`
+	Quantity   int32 `gorm:"not null;default:0"`
+	SupplierID int32 `gorm:"not null;default:0;uniqueIndex:idx_supply_book_supplier,priority:1"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 type Supplier struct {
@@ -97,19 +359,39 @@ type Supplier struct {
 	BasicInfo   string        `gorm:"size:1024"`
 	SupplyInfo  string        `gorm:"size:1024"`
 	SupplyBooks []*SupplyBook `gorm:"foreignKey:SupplierID"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// 首选供应商，按首选策略生成采购单时优先使用
+	Preferred bool `gorm:"not null;default:false"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
-// AutoMigrate 自动迁移函数
-func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&Book{},
-		&Customer{},
-		&StockRequest{},
-		&PurchaseOrder{},
-		&CustomerOrder{},
-		&Supplier{},
-		&SupplyBook{},
-	)
+// 通知发件箱中的消息，与业务数据在同一个事务中写入，由后台分发器发送
+type OutboxMessage struct {
+	ID int32 `gorm:"primaryKey"`
+	// 通知事件，对应通知模板的名称
+	Event string `gorm:"size:100;not null"`
+	// 通知渠道：email 或 sms
+	Channel string `gorm:"size:20;not null;default:'email'"`
+	// 接收通知的客户在线ID、邮箱地址和手机号
+	Recipient string `gorm:"size:255;not null"`
+	Email     string `gorm:"size:255"`
+	Phone     string `gorm:"size:50"`
+	Subject   string `gorm:"size:512;not null"`
+	Body      string `gorm:"type:text;not null"`
+	// 状态：pending、sent 或 failed
+	Status string `gorm:"size:20;not null;index:idx_outbox_due,priority:1"`
+	// 已尝试发送的次数，下一次尝试的时间
+	Attempts      int32     `gorm:"not null;default:0"`
+	NextAttemptAt time.Time `gorm:"index:idx_outbox_due,priority:2"`
+	LastError     string    `gorm:"size:1024"`
+	SentAt        *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
+
+// 发件箱消息的状态
+const (
+	OutboxPending = "pending"
+	OutboxSent    = "sent"
+	OutboxFailed  = "failed"
+)
@@ -0,0 +1,168 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/GoldenStain/goDB/models"
+	"gorm.io/gorm"
+)
+
+// 分发器的默认参数
+const (
+	defaultBatchSize   = 100
+	defaultMaxAttempts = 8
+	defaultBaseBackoff = 30 * time.Second
+	defaultMaxBackoff  = time.Hour
+	// 取出的消息在这段时间内不会被其他分发器重复发送，发送过程中进程退出时超时后重新发送
+	claimTimeout = 5 * time.Minute
+)
+
+// Dispatcher 从发件箱中取出到期的消息并发送，失败的消息按指数退避重试，超过最大次数后标记为 failed
+type Dispatcher struct {
+	db     *gorm.DB
+	sender Sender
+	// 每次最多发送的消息数
+	BatchSize int
+	// 最多尝试发送的次数
+	MaxAttempts int32
+	// 第 n 次失败后等待 BaseBackoff * 2^(n-1)，最多等待 MaxBackoff
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// 当前时间，测试时可以替换
+	now func() time.Time
+}
+
+// NewDispatcher 用于创建 Dispatcher
+func NewDispatcher(db *gorm.DB, sender Sender) *Dispatcher {
+	return &Dispatcher{
+		db:          db,
+		sender:      sender,
+		BatchSize:   defaultBatchSize,
+		MaxAttempts: defaultMaxAttempts,
+		BaseBackoff: defaultBaseBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+		now:         time.Now,
+	}
+}
+
+// Run 每隔 interval 发送一次到期的消息，直到 ctx 被取消
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := d.DispatchPending(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("发送通知失败: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending 发送一批到期的消息，返回发送成功的数量
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	var messages []*models.OutboxMessage
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND next_attempt_at <= ?", models.OutboxPending, d.now()).
+		Order("id").
+		Limit(d.BatchSize).
+		Find(&messages).Error
+	if err != nil {
+		return 0, fmt.Errorf("无法查询发件箱: %w", err)
+	}
+
+	sent := 0
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+		claimed, err := d.claim(ctx, message)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		sendErr := d.sender.Send(ctx, Message{
+			ID:        message.ID,
+			Channel:   message.Channel,
+			Recipient: message.Recipient,
+			Email:     message.Email,
+			Phone:     message.Phone,
+			Subject:   message.Subject,
+			Body:      message.Body,
+		})
+		if err := d.finish(message, sendErr); err != nil {
+			return sent, err
+		}
+		if sendErr == nil {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// claim 增加消息的发送次数并推迟下一次尝试的时间，其他分发器已经取出该消息时返回 false
+func (d *Dispatcher) claim(ctx context.Context, message *models.OutboxMessage) (bool, error) {
+	result := d.db.WithContext(ctx).Model(&models.OutboxMessage{}).
+		Where("id = ? AND status = ? AND attempts = ?", message.ID, models.OutboxPending, message.Attempts).
+		Updates(map[string]interface{}{
+			"attempts":        message.Attempts + 1,
+			"next_attempt_at": d.now().Add(claimTimeout),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("无法取出消息 %d: %w", message.ID, result.Error)
+	}
+	message.Attempts++
+	return result.RowsAffected == 1, nil
+}
+
+// finish 记录发送结果，失败时计算下一次尝试的时间
+func (d *Dispatcher) finish(message *models.OutboxMessage, sendErr error) error {
+	now := d.now()
+	updates := map[string]interface{}{}
+	switch {
+	case sendErr == nil:
+		updates["status"] = models.OutboxSent
+		updates["sent_at"] = now
+		updates["last_error"] = ""
+	case IsPermanent(sendErr) || message.Attempts >= d.MaxAttempts:
+		updates["status"] = models.OutboxFailed
+		updates["last_error"] = truncate(sendErr.Error(), 1024)
+	default:
+		updates["next_attempt_at"] = now.Add(d.backoff(message.Attempts))
+		updates["last_error"] = truncate(sendErr.Error(), 1024)
+	}
+
+	// 发送结果与客户端是否取消无关，总是要记录
+	if err := d.db.Model(&models.OutboxMessage{}).Where("id = ?", message.ID).Updates(updates).Error; err != nil {
+		return fmt.Errorf("无法更新消息 %d: %w", message.ID, err)
+	}
+	return nil
+}
+
+// backoff 返回第 attempts 次失败后的等待时间
+func (d *Dispatcher) backoff(attempts int32) time.Duration {
+	wait := d.BaseBackoff
+	for i := int32(1); i < attempts && wait < d.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > d.MaxBackoff {
+		wait = d.MaxBackoff
+	}
+	return wait
+}
+
+// truncate 截断过长的错误信息
+func truncate(value string, size int) string {
+	if len(value) <= size {
+		return value
+	}
+	return value[:size]
+}
@@ -0,0 +1,100 @@
+// Package notification 发送客户通知。业务代码在自己的事务中调用 Enqueue 把通知写入发件箱，
+// 事务提交后由 Dispatcher 在后台通过 Sender 发送，发送失败时按退避时间重试。
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GoldenStain/goDB/models"
+	"gorm.io/gorm"
+)
+
+// Message 是一条待发送的通知
+type Message struct {
+	// 发件箱中的消息ID
+	ID int32
+	// 通知渠道：email 或 sms
+	Channel string
+	// 接收通知的客户在线ID、邮箱地址和手机号
+	Recipient string
+	Email     string
+	Phone     string
+	Subject   string
+	Body      string
+}
+
+// Sender 发送通知，返回 Permanent 包装的错误时不再重试
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// Recipient 是通知的接收者
+type Recipient struct {
+	OnlineID string
+	Email    string
+	Phone    string
+}
+
+// Router 按通知渠道选择 Sender，没有对应 Sender 的渠道不再重试
+type Router map[string]Sender
+
+// Send 用消息渠道对应的 Sender 发送
+func (r Router) Send(ctx context.Context, message Message) error {
+	sender, ok := r[message.Channel]
+	if !ok {
+		return Permanent(fmt.Errorf("没有配置 %s 渠道的发送方式", message.Channel))
+	}
+	return sender.Send(ctx, message)
+}
+
+// permanentError 表示重试也无法成功的发送错误
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent 把错误标记为无法重试，例如收件地址无效
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// IsPermanent 判断错误是否无法重试
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// Enqueue 用事件对应的模板生成通知并通过 channel 渠道写入发件箱。
+// tx 应当是业务修改所在的事务，事务回滚时通知也不会发送。
+func Enqueue(tx *gorm.DB, event, channel string, recipient Recipient, data interface{}) error {
+	subject, body, err := render(event, data)
+	if err != nil {
+		return err
+	}
+
+	message := &models.OutboxMessage{
+		Event:         event,
+		Channel:       channel,
+		Recipient:     recipient.OnlineID,
+		Email:         recipient.Email,
+		Phone:         recipient.Phone,
+		Subject:       subject,
+		Body:          body,
+		Status:        models.OutboxPending,
+		NextAttemptAt: time.Now(),
+	}
+	if err := tx.Create(message).Error; err != nil {
+		return fmt.Errorf("无法写入发件箱: %w", err)
+	}
+	return nil
+}
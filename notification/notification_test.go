@@ -0,0 +1,151 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/GoldenStain/goDB/migrations"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	if err := migrations.Up(db); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	return db
+}
+
+// fakeSender 记录收到的消息，按顺序返回 errs 中的错误
+type fakeSender struct {
+	sent []Message
+	errs []error
+}
+
+func (s *fakeSender) Send(ctx context.Context, message Message) error {
+	s.sent = append(s.sent, message)
+	if len(s.errs) == 0 {
+		return nil
+	}
+	err := s.errs[0]
+	s.errs = s.errs[1:]
+	return err
+}
+
+func TestEnqueue(t *testing.T) {
+	db := setupTestDB(t)
+	recipient := Recipient{OnlineID: "customer1", Email: "customer1@example.com"}
+	data := BookAvailableData{CustomerName: "Customer 1", BookNo: "B001", Title: "Book 1"}
+
+	// 事务回滚时通知不会写入发件箱
+	db.Transaction(func(tx *gorm.DB) error {
+		assert.NoError(t, Enqueue(tx, EventBookAvailable, models.NotificationChannelEmail, recipient, data))
+		return errors.New("rollback")
+	})
+	var count int64
+	db.Model(&models.OutboxMessage{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	// 用模板生成主题和正文
+	assert.NoError(t, Enqueue(db, EventBookAvailable, models.NotificationChannelEmail, recipient, data))
+	var message models.OutboxMessage
+	assert.NoError(t, db.First(&message).Error)
+	assert.Equal(t, "您要的书目《Book 1》已经上新", message.Subject)
+	assert.Contains(t, message.Body, "客户Customer 1您好")
+	assert.Equal(t, "customer1@example.com", message.Email)
+	assert.Equal(t, models.NotificationChannelEmail, message.Channel)
+	assert.Equal(t, models.OutboxPending, message.Status)
+
+	// 未知的事件和缺少的模板数据
+	assert.ErrorContains(t, Enqueue(db, "unknown", models.NotificationChannelEmail, recipient, data), "unknown")
+	assert.Error(t, Enqueue(db, EventBookAvailable, models.NotificationChannelEmail, recipient, map[string]string{}))
+}
+
+func TestDispatcher(t *testing.T) {
+	db := setupTestDB(t)
+	sender := &fakeSender{errs: []error{nil, errors.New("connection refused"), Permanent(errors.New("no address"))}}
+	dispatcher := NewDispatcher(db, sender)
+	dispatcher.MaxAttempts = 3
+	for i := 1; i <= 3; i++ {
+		recipient := Recipient{OnlineID: fmt.Sprintf("customer%d", i)}
+		assert.NoError(t, Enqueue(db, EventBookAvailable, models.NotificationChannelEmail, recipient, BookAvailableData{Title: "Book"}))
+	}
+	now := time.Now()
+	dispatcher.now = func() time.Time { return now }
+
+	// 第一条发送成功，第二条稍后重试，第三条不再重试
+	sent, err := dispatcher.DispatchPending(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	var messages []models.OutboxMessage
+	db.Order("id").Find(&messages)
+	assert.Equal(t, models.OutboxSent, messages[0].Status)
+	assert.NotNil(t, messages[0].SentAt)
+	assert.Equal(t, models.OutboxPending, messages[1].Status)
+	assert.Equal(t, "connection refused", messages[1].LastError)
+	assert.WithinDuration(t, now.Add(dispatcher.BaseBackoff), messages[1].NextAttemptAt, time.Second)
+	assert.Equal(t, models.OutboxFailed, messages[2].Status)
+
+	// 退避时间未到时不会重试
+	sent, err = dispatcher.DispatchPending(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 3, len(sender.sent))
+
+	// 每次失败后等待时间翻倍，超过最大次数后标记为 failed
+	sender.errs = []error{errors.New("timeout"), errors.New("timeout")}
+	now = now.Add(dispatcher.BaseBackoff)
+	dispatcher.DispatchPending(context.Background())
+	db.First(&messages[1], messages[1].ID)
+	assert.Equal(t, int32(2), messages[1].Attempts)
+	assert.WithinDuration(t, now.Add(2*dispatcher.BaseBackoff), messages[1].NextAttemptAt, time.Second)
+	now = now.Add(2 * dispatcher.BaseBackoff)
+	dispatcher.DispatchPending(context.Background())
+	db.First(&messages[1], messages[1].ID)
+	assert.Equal(t, models.OutboxFailed, messages[1].Status)
+	assert.Equal(t, int32(3), messages[1].Attempts)
+}
+
+func TestBackoff(t *testing.T) {
+	dispatcher := NewDispatcher(nil, nil)
+	dispatcher.BaseBackoff = time.Second
+	dispatcher.MaxBackoff = 10 * time.Second
+	assert.Equal(t, time.Second, dispatcher.backoff(1))
+	assert.Equal(t, 4*time.Second, dispatcher.backoff(3))
+	assert.Equal(t, 10*time.Second, dispatcher.backoff(5))
+	assert.Equal(t, 10*time.Second, dispatcher.backoff(100))
+}
+
+func TestFileSender(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "notifications")
+	sender := NewFileSender(dir)
+	err := sender.Send(context.Background(), Message{ID: 7, Channel: models.NotificationChannelSMS, Recipient: "customer/1", Subject: "Subject", Body: "Body\n"})
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "7_sms_customer_1.txt"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "Subject: Subject")
+	assert.Contains(t, string(content), "Body")
+}
+
+func TestRouter(t *testing.T) {
+	email := &fakeSender{}
+	router := Router{models.NotificationChannelEmail: email}
+	assert.NoError(t, router.Send(context.Background(), Message{Channel: models.NotificationChannelEmail}))
+	assert.Equal(t, 1, len(email.sent))
+
+	// 没有配置发送方式的渠道不再重试
+	err := router.Send(context.Background(), Message{Channel: models.NotificationChannelSMS})
+	assert.True(t, IsPermanent(err))
+}
@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileSender 把通知写入目录中的文件，用于开发和测试环境
+type FileSender struct {
+	dir string
+}
+
+// NewFileSender 用于创建 FileSender
+func NewFileSender(dir string) *FileSender {
+	return &FileSender{
+		dir: dir,
+	}
+}
+
+// Send 把通知写入 <发件箱ID>_<渠道>_<客户在线ID>.txt
+func (s *FileSender) Send(ctx context.Context, message Message) error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	content := fmt.Sprintf("Channel: %s\nTo: %s <%s%s>\nSubject: %s\n\n%s", message.Channel, message.Recipient, message.Email, message.Phone, message.Subject, message.Body)
+	name := fmt.Sprintf("%d_%s_%s.txt", message.ID, message.Channel, sanitizeFileName(message.Recipient))
+	return os.WriteFile(filepath.Join(s.dir, name), []byte(content), 0644)
+}
+
+// sanitizeFileName 替换文件名中不能使用的字符
+func sanitizeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+// LogSender 把通知输出到日志，不实际发送
+type LogSender struct {
+	logger *log.Logger
+}
+
+// NewLogSender 用于创建 LogSender，logger 为空时使用标准日志
+func NewLogSender(logger *log.Logger) *LogSender {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogSender{
+		logger: logger,
+	}
+}
+
+// Send 输出通知的接收者和主题
+func (s *LogSender) Send(ctx context.Context, message Message) error {
+	s.logger.Printf("%s 通知 %d 发送给 %s <%s%s>: %s", message.Channel, message.ID, message.Recipient, message.Email, message.Phone, message.Subject)
+	return nil
+}
@@ -0,0 +1,127 @@
+package notification
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/GoldenStain/goDB/models"
+)
+
+// SMTPSender 通过 SMTP 服务器发送邮件，服务器支持 STARTTLS 时加密连接
+type SMTPSender struct {
+	// 服务器地址，格式为 host:port
+	addr string
+	from string
+	// 用户名为空时不认证
+	username string
+	password string
+	// 连接和发送的超时时间
+	timeout time.Duration
+}
+
+// NewSMTPSender 用于创建 SMTPSender
+func NewSMTPSender(addr, from, username, password string) *SMTPSender {
+	return &SMTPSender{
+		addr:     addr,
+		from:     from,
+		username: username,
+		password: password,
+		timeout:  30 * time.Second,
+	}
+}
+
+// Send 发送一封邮件，不是邮件渠道、客户没有邮箱地址或者服务器返回 5xx 时不再重试
+func (s *SMTPSender) Send(ctx context.Context, message Message) error {
+	if message.Channel != models.NotificationChannelEmail {
+		return Permanent(fmt.Errorf("SMTP 不能发送 %s 渠道的通知", message.Channel))
+	}
+	if message.Email == "" {
+		return Permanent(fmt.Errorf("客户 %s 没有邮箱地址", message.Recipient))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	host, _, _ := net.SplitHostPort(s.addr)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	err = s.deliver(client, host, message)
+	var protocolErr *textproto.Error
+	if errors.As(err, &protocolErr) && protocolErr.Code >= 500 {
+		return Permanent(err)
+	}
+	return err
+}
+
+// deliver 在已建立的连接上发送邮件
+func (s *SMTPSender) deliver(client *smtp.Client, host string, message Message) error {
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(s.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(message.Email); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(formatEmail(s.from, message)); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// formatEmail 生成邮件内容，主题和正文使用 UTF-8 编码
+func formatEmail(from string, message Message) []byte {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "From: %s\r\n", from)
+	fmt.Fprintf(&builder, "To: %s\r\n", message.Email)
+	fmt.Fprintf(&builder, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", message.Subject))
+	fmt.Fprintf(&builder, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	builder.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	// 正文按每行 76 个字符折行
+	encoded := base64.StdEncoding.EncodeToString([]byte(message.Body))
+	for len(encoded) > 76 {
+		builder.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	builder.WriteString(encoded + "\r\n")
+	return []byte(builder.String())
+}
@@ -0,0 +1,123 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"mime"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSMTPMail 是假 SMTP 服务器收到的一封邮件
+type fakeSMTPMail struct {
+	From string
+	To   []string
+	Data string
+}
+
+// startFakeSMTPServer 启动一个只支持基本命令的本地 SMTP 服务器，rejectRcpt 中的收件人返回 550
+func startFakeSMTPServer(t *testing.T, rejectRcpt string) (string, <-chan fakeSMTPMail) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	mails := make(chan fakeSMTPMail, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveFakeSMTP(conn, rejectRcpt, mails)
+		}
+	}()
+	return listener.Addr().String(), mails
+}
+
+func serveFakeSMTP(conn net.Conn, rejectRcpt string, mails chan<- fakeSMTPMail) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	text.PrintfLine("220 localhost fake SMTP")
+
+	var mail fakeSMTPMail
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			text.PrintfLine("250 localhost")
+		case "MAIL":
+			mail = fakeSMTPMail{From: strings.TrimPrefix(line, "MAIL FROM:")}
+			text.PrintfLine("250 OK")
+		case "RCPT":
+			to := strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>")
+			if to == rejectRcpt {
+				text.PrintfLine("550 No such user")
+				continue
+			}
+			mail.To = append(mail.To, to)
+			text.PrintfLine("250 OK")
+		case "DATA":
+			text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			mail.Data = string(data)
+			mails <- mail
+			text.PrintfLine("250 OK")
+		case "QUIT":
+			text.PrintfLine("221 Bye")
+			return
+		default:
+			text.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func TestSMTPSender(t *testing.T) {
+	addr, mails := startFakeSMTPServer(t, "unknown@example.com")
+	sender := NewSMTPSender(addr, "store@example.com", "", "")
+
+	// 发送一封中文邮件
+	message := Message{ID: 1, Channel: models.NotificationChannelEmail, Recipient: "customer1", Email: "customer1@example.com", Subject: "您要的书目已经上新", Body: "客户您好\n"}
+	assert.NoError(t, sender.Send(context.Background(), message))
+	mail := <-mails
+	assert.Equal(t, []string{"customer1@example.com"}, mail.To)
+
+	// 解析邮件头和正文
+	reader := textproto.NewReader(bufio.NewReader(strings.NewReader(mail.Data)))
+	header, err := reader.ReadMIMEHeader()
+	assert.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, message.Subject, subject)
+	encoded, _ := reader.ReadDotBytes()
+	body, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\n", ""))
+	assert.NoError(t, err)
+	assert.Equal(t, message.Body, string(body))
+
+	// 服务器拒绝收件人和没有邮箱地址时不再重试
+	err = sender.Send(context.Background(), Message{Channel: models.NotificationChannelEmail, Recipient: "customer2", Email: "unknown@example.com"})
+	assert.True(t, IsPermanent(err))
+	err = sender.Send(context.Background(), Message{Channel: models.NotificationChannelEmail, Recipient: "customer3"})
+	assert.True(t, IsPermanent(err))
+	err = sender.Send(context.Background(), Message{Channel: models.NotificationChannelSMS, Recipient: "customer3", Phone: "13800000000"})
+	assert.True(t, IsPermanent(err))
+
+	// 服务器无法连接时可以重试
+	err = NewSMTPSender("127.0.0.1:1", "store@example.com", "", "").Send(context.Background(), message)
+	assert.Error(t, err)
+	assert.False(t, IsPermanent(err))
+}
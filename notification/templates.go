@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+)
+
+// 通知事件，每个事件对应 templates 目录下的同名模板
+const (
+	// EventBookAvailable 客户订购的缺货书目已经上新，模板数据为 BookAvailableData
+	EventBookAvailable = "book_available"
+	// EventWaitlistFulfilled 客户等待的书目到货后已经自动下单，模板数据为 WaitlistFulfilledData
+	EventWaitlistFulfilled = "waitlist_fulfilled"
+)
+
+// BookAvailableData 是书目上新通知的模板数据
+type BookAvailableData struct {
+	CustomerName string
+	BookNo       string
+	Title        string
+}
+
+// WaitlistFulfilledData 是到货自动下单通知的模板数据
+type WaitlistFulfilledData struct {
+	CustomerName string
+	BookNo       string
+	Title        string
+	Quantity     int32
+	OrderID      int32
+	PaidAmount   int32
+}
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// templates 按事件名称保存解析后的模板，每个模板定义 subject 和 body 两部分
+var templates = mustParseTemplates()
+
+func mustParseTemplates() map[string]*template.Template {
+	files, err := templateFS.ReadDir("templates")
+	if err != nil {
+		panic(err)
+	}
+	parsed := make(map[string]*template.Template)
+	for _, file := range files {
+		event := strings.TrimSuffix(file.Name(), ".tmpl")
+		parsed[event] = template.Must(template.New(event).Option("missingkey=error").ParseFS(templateFS, path.Join("templates", file.Name())))
+	}
+	return parsed
+}
+
+// KnownEvent 判断是否有 event 对应的通知模板
+func KnownEvent(event string) bool {
+	_, ok := templates[event]
+	return ok
+}
+
+// render 用事件对应的模板生成通知的主题和正文
+func render(event string, data interface{}) (string, string, error) {
+	tmpl, ok := templates[event]
+	if !ok {
+		return "", "", fmt.Errorf("未知的通知事件 %q", event)
+	}
+	var subject, body strings.Builder
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", fmt.Errorf("无法生成通知 %s 的主题: %w", event, err)
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", fmt.Errorf("无法生成通知 %s 的正文: %w", event, err)
+	}
+	return strings.TrimSpace(subject.String()), strings.TrimSpace(body.String()) + "\n", nil
+}
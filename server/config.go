@@ -0,0 +1,364 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/GoldenStain/goDB/services"
+)
+
+// 配置文件路径的环境变量，命令行参数 -config 优先
+const configPathEnv = "GODB_CONFIG"
+
+// 默认监听地址和日志级别
+const (
+	defaultListenAddr = ":50051"
+	defaultLogLevel   = "warn"
+)
+
+// 通知的发送方式
+const (
+	SenderSMTP = "smtp"
+	SenderFile = "file"
+	SenderLog  = "log"
+)
+
+// 通知的默认配置
+const (
+	defaultSMTPPort                = 587
+	defaultNotificationDir         = "notifications"
+	defaultNotificationInterval    = 10
+	defaultNotificationMaxAttempts = 8
+)
+
+// 库存预留的默认配置
+const (
+	defaultReservationTTL           = 900
+	defaultReservationMaxTTL        = 3600
+	defaultReservationSweepInterval = 60
+)
+
+// 自动补货检查库存的默认间隔秒数
+const defaultReplenishmentInterval = 300
+
+// 可用的日志级别，对应 gorm 的日志级别
+var logLevels = map[string]bool{
+	"silent": true,
+	"error":  true,
+	"warn":   true,
+	"info":   true,
+}
+
+// Config 用来读取配置文件中的数据，每一项都可以用对应的环境变量覆盖
+type Config struct {
+	DB struct {
+		// 数据库驱动：mysql、postgres 或 sqlite，默认为 mysql
+		Driver   string `json:"driver"`
+		Host     string `json:"host"`
+		Port     int    `json:"port"`
+		User     string `json:"user"`
+		Password string `json:"password"`
+		DBName   string `json:"dbname"`
+		// PostgreSQL 的 sslmode，默认为 disable
+		SSLMode string `json:"sslmode"`
+		// SQLite 数据库文件路径
+		Path string `json:"path"`
+	} `json:"db"`
+	Threshold int32 `json:"threshold"`
+	Auth      struct {
+		// 令牌签名密钥，为空时每次启动随机生成，重启后之前签发的令牌失效
+		Secret          string `json:"secret"`
+		TokenTTLMinutes int    `json:"token_ttl_minutes"`
+		// 管理员账号，启动时不存在则创建，为空时不创建
+		AdminOnlineID string `json:"admin_online_id"`
+		AdminPassword string `json:"admin_password"`
+	} `json:"auth"`
+	Server struct {
+		// 监听地址，默认为 :50051
+		ListenAddr string `json:"listen_addr"`
+		// TLS 证书和私钥文件，都为空时不启用 TLS
+		TLSCertFile string `json:"tls_cert_file"`
+		TLSKeyFile  string `json:"tls_key_file"`
+	} `json:"server"`
+	Notification struct {
+		// 发送方式：smtp、file 或 log，默认为 log
+		Sender string `json:"sender"`
+		// file 方式保存通知的目录，默认为 notifications
+		Dir  string `json:"dir"`
+		SMTP struct {
+			Host string `json:"host"`
+			// 默认为 587
+			Port int `json:"port"`
+			// 用户名为空时不认证
+			Username string `json:"username"`
+			Password string `json:"password"`
+			From     string `json:"from"`
+		} `json:"smtp"`
+		// 扫描发件箱的间隔秒数，默认为 10
+		IntervalSeconds int `json:"interval_seconds"`
+		// 每条通知最多尝试发送的次数，默认为 8
+		MaxAttempts int `json:"max_attempts"`
+	} `json:"notification"`
+	Reservation struct {
+		// 预留库存默认保留的秒数，默认为 900
+		TTLSeconds int `json:"ttl_seconds"`
+		// 客户可以请求的最长保留秒数，默认为 3600
+		MaxTTLSeconds int `json:"max_ttl_seconds"`
+		// 释放过期预留的间隔秒数，默认为 60
+		SweepIntervalSeconds int `json:"sweep_interval_seconds"`
+	} `json:"reservation"`
+	Replenishment struct {
+		// 检查书籍是否低于补货点的间隔秒数，默认为 300
+		IntervalSeconds int `json:"interval_seconds"`
+	} `json:"replenishment"`
+	Purchasing struct {
+		// 生成采购单时选择供应商的策略：cheapest、most_stock 或 preferred，默认为 cheapest
+		SupplierPolicy string `json:"supplier_policy"`
+	} `json:"purchasing"`
+	// 日志级别：silent、error、warn 或 info，默认为 warn
+	LogLevel string `json:"log_level"`
+}
+
+// ConfigPath 返回配置文件路径，依次使用命令行参数、GODB_CONFIG 环境变量和工作目录下的 server/config.json
+func ConfigPath(flagPath string) (string, error) {
+	if flagPath != "" {
+		return flagPath, nil
+	}
+	if envPath := os.Getenv(configPathEnv); envPath != "" {
+		return envPath, nil
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("无法读取工作目录: %w", err)
+	}
+	return filepath.Join(dir, "server", "config.json"), nil
+}
+
+// LoadConfig 读取配置文件，用环境变量覆盖后补充默认值并验证
+func LoadConfig(file string) (*Config, error) {
+	var config Config
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("无法读取配置文件 %s: %w", file, err)
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("无法解析配置文件 %s: %w", file, err)
+	}
+
+	if err := config.applyEnv(); err != nil {
+		return nil, err
+	}
+	config.applyDefaults()
+
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("配置无效:\n%w", err)
+	}
+	return &config, nil
+}
+
+// applyEnv 用环境变量覆盖配置文件中的值，只覆盖已设置的环境变量
+func (c *Config) applyEnv() error {
+	stringEnvs := map[string]*string{
+		"GODB_DB_DRIVER":                  &c.DB.Driver,
+		"GODB_DB_HOST":                    &c.DB.Host,
+		"GODB_DB_USER":                    &c.DB.User,
+		"GODB_DB_PASSWORD":                &c.DB.Password,
+		"GODB_DB_NAME":                    &c.DB.DBName,
+		"GODB_DB_SSLMODE":                 &c.DB.SSLMode,
+		"GODB_DB_PATH":                    &c.DB.Path,
+		"GODB_AUTH_SECRET":                &c.Auth.Secret,
+		"GODB_AUTH_ADMIN_ONLINE_ID":       &c.Auth.AdminOnlineID,
+		"GODB_AUTH_ADMIN_PASSWORD":        &c.Auth.AdminPassword,
+		"GODB_LISTEN_ADDR":                &c.Server.ListenAddr,
+		"GODB_TLS_CERT_FILE":              &c.Server.TLSCertFile,
+		"GODB_TLS_KEY_FILE":               &c.Server.TLSKeyFile,
+		"GODB_LOG_LEVEL":                  &c.LogLevel,
+		"GODB_NOTIFICATION_SENDER":        &c.Notification.Sender,
+		"GODB_PURCHASING_SUPPLIER_POLICY": &c.Purchasing.SupplierPolicy,
+		"GODB_NOTIFICATION_DIR":           &c.Notification.Dir,
+		"GODB_SMTP_HOST":                  &c.Notification.SMTP.Host,
+		"GODB_SMTP_USERNAME":              &c.Notification.SMTP.Username,
+		"GODB_SMTP_PASSWORD":              &c.Notification.SMTP.Password,
+		"GODB_SMTP_FROM":                  &c.Notification.SMTP.From,
+	}
+	for name, field := range stringEnvs {
+		if value, ok := os.LookupEnv(name); ok {
+			*field = value
+		}
+	}
+
+	intEnvs := map[string]*int{
+		"GODB_DB_PORT":                            &c.DB.Port,
+		"GODB_AUTH_TOKEN_TTL_MINUTES":             &c.Auth.TokenTTLMinutes,
+		"GODB_SMTP_PORT":                          &c.Notification.SMTP.Port,
+		"GODB_NOTIFICATION_INTERVAL_SECONDS":      &c.Notification.IntervalSeconds,
+		"GODB_NOTIFICATION_MAX_ATTEMPTS":          &c.Notification.MaxAttempts,
+		"GODB_RESERVATION_TTL_SECONDS":            &c.Reservation.TTLSeconds,
+		"GODB_RESERVATION_MAX_TTL_SECONDS":        &c.Reservation.MaxTTLSeconds,
+		"GODB_RESERVATION_SWEEP_INTERVAL_SECONDS": &c.Reservation.SweepIntervalSeconds,
+		"GODB_REPLENISHMENT_INTERVAL_SECONDS":     &c.Replenishment.IntervalSeconds,
+	}
+	for name, field := range intEnvs {
+		if value, ok := os.LookupEnv(name); ok {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("环境变量 %s 必须是整数: %q", name, value)
+			}
+			*field = parsed
+		}
+	}
+
+	if value, ok := os.LookupEnv("GODB_THRESHOLD"); ok {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("环境变量 GODB_THRESHOLD 必须是整数: %q", value)
+		}
+		c.Threshold = int32(parsed)
+	}
+	return nil
+}
+
+// applyDefaults 为未设置的可选配置补充默认值
+func (c *Config) applyDefaults() {
+	if c.DB.Driver == "" {
+		c.DB.Driver = DriverMySQL
+	}
+	if c.DB.Port == 0 {
+		c.DB.Port = defaultPorts[c.DB.Driver]
+	}
+	if c.DB.Driver == DriverPostgres && c.DB.SSLMode == "" {
+		c.DB.SSLMode = "disable"
+	}
+	if c.Server.ListenAddr == "" {
+		c.Server.ListenAddr = defaultListenAddr
+	}
+	if c.LogLevel == "" {
+		c.LogLevel = defaultLogLevel
+	}
+	if c.Notification.Sender == "" {
+		c.Notification.Sender = SenderLog
+	}
+	if c.Notification.Dir == "" {
+		c.Notification.Dir = defaultNotificationDir
+	}
+	if c.Notification.SMTP.Port == 0 {
+		c.Notification.SMTP.Port = defaultSMTPPort
+	}
+	if c.Notification.IntervalSeconds == 0 {
+		c.Notification.IntervalSeconds = defaultNotificationInterval
+	}
+	if c.Notification.MaxAttempts == 0 {
+		c.Notification.MaxAttempts = defaultNotificationMaxAttempts
+	}
+	if c.Reservation.TTLSeconds == 0 {
+		c.Reservation.TTLSeconds = defaultReservationTTL
+	}
+	if c.Reservation.MaxTTLSeconds == 0 {
+		c.Reservation.MaxTTLSeconds = defaultReservationMaxTTL
+	}
+	if c.Reservation.SweepIntervalSeconds == 0 {
+		c.Reservation.SweepIntervalSeconds = defaultReservationSweepInterval
+	}
+	if c.Replenishment.IntervalSeconds == 0 {
+		c.Replenishment.IntervalSeconds = defaultReplenishmentInterval
+	}
+	if c.Purchasing.SupplierPolicy == "" {
+		c.Purchasing.SupplierPolicy = services.SupplierPolicyCheapest
+	}
+}
+
+// validate 验证配置，返回所有错误
+func (c *Config) validate() error {
+	var errs []error
+	switch c.DB.Driver {
+	case DriverMySQL, DriverPostgres:
+		// 网络数据库需要连接信息
+		if c.DB.Host == "" {
+			errs = append(errs, errors.New("db.host 不能为空 (GODB_DB_HOST)"))
+		}
+		if c.DB.Port <= 0 || c.DB.Port > 65535 {
+			errs = append(errs, fmt.Errorf("db.port 必须在 1 到 65535 之间 (GODB_DB_PORT)，当前为 %d", c.DB.Port))
+		}
+		if c.DB.User == "" {
+			errs = append(errs, errors.New("db.user 不能为空 (GODB_DB_USER)"))
+		}
+		if c.DB.DBName == "" {
+			errs = append(errs, errors.New("db.dbname 不能为空 (GODB_DB_NAME)"))
+		}
+	case DriverSQLite:
+		// 嵌入式数据库只需要文件路径
+		if c.DB.Path == "" {
+			errs = append(errs, errors.New("db.path 不能为空 (GODB_DB_PATH)"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("db.driver 必须是 mysql、postgres 或 sqlite (GODB_DB_DRIVER)，当前为 %q", c.DB.Driver))
+	}
+	if c.Threshold < 0 || c.Threshold > 100 {
+		errs = append(errs, fmt.Errorf("threshold 必须在 0 到 100 之间 (GODB_THRESHOLD)，当前为 %d", c.Threshold))
+	}
+	if c.Auth.TokenTTLMinutes < 0 {
+		errs = append(errs, fmt.Errorf("auth.token_ttl_minutes 不能为负数 (GODB_AUTH_TOKEN_TTL_MINUTES)，当前为 %d", c.Auth.TokenTTLMinutes))
+	}
+	if _, _, err := net.SplitHostPort(c.Server.ListenAddr); err != nil {
+		errs = append(errs, fmt.Errorf("server.listen_addr 无效 (GODB_LISTEN_ADDR): %v", err))
+	}
+	if (c.Server.TLSCertFile == "") != (c.Server.TLSKeyFile == "") {
+		errs = append(errs, errors.New("server.tls_cert_file 和 server.tls_key_file 必须同时设置 (GODB_TLS_CERT_FILE, GODB_TLS_KEY_FILE)"))
+	}
+	for _, file := range []string{c.Server.TLSCertFile, c.Server.TLSKeyFile} {
+		if file == "" {
+			continue
+		}
+		if _, err := os.Stat(file); err != nil {
+			errs = append(errs, fmt.Errorf("无法读取 TLS 文件 %s: %v", file, err))
+		}
+	}
+	if !logLevels[c.LogLevel] {
+		errs = append(errs, fmt.Errorf("log_level 必须是 silent、error、warn 或 info (GODB_LOG_LEVEL)，当前为 %q", c.LogLevel))
+	}
+	switch c.Notification.Sender {
+	case SenderSMTP:
+		if c.Notification.SMTP.Host == "" {
+			errs = append(errs, errors.New("notification.smtp.host 不能为空 (GODB_SMTP_HOST)"))
+		}
+		if c.Notification.SMTP.Port <= 0 || c.Notification.SMTP.Port > 65535 {
+			errs = append(errs, fmt.Errorf("notification.smtp.port 必须在 1 到 65535 之间 (GODB_SMTP_PORT)，当前为 %d", c.Notification.SMTP.Port))
+		}
+		if c.Notification.SMTP.From == "" {
+			errs = append(errs, errors.New("notification.smtp.from 不能为空 (GODB_SMTP_FROM)"))
+		}
+	case SenderFile, SenderLog:
+	default:
+		errs = append(errs, fmt.Errorf("notification.sender 必须是 smtp、file 或 log (GODB_NOTIFICATION_SENDER)，当前为 %q", c.Notification.Sender))
+	}
+	if c.Notification.IntervalSeconds < 0 {
+		errs = append(errs, fmt.Errorf("notification.interval_seconds 不能为负数 (GODB_NOTIFICATION_INTERVAL_SECONDS)，当前为 %d", c.Notification.IntervalSeconds))
+	}
+	if c.Notification.MaxAttempts < 0 {
+		errs = append(errs, fmt.Errorf("notification.max_attempts 不能为负数 (GODB_NOTIFICATION_MAX_ATTEMPTS)，当前为 %d", c.Notification.MaxAttempts))
+	}
+	if c.Reservation.TTLSeconds < 0 {
+		errs = append(errs, fmt.Errorf("reservation.ttl_seconds 不能为负数 (GODB_RESERVATION_TTL_SECONDS)，当前为 %d", c.Reservation.TTLSeconds))
+	}
+	if c.Reservation.MaxTTLSeconds < c.Reservation.TTLSeconds {
+		errs = append(errs, fmt.Errorf("reservation.max_ttl_seconds 不能小于 reservation.ttl_seconds (GODB_RESERVATION_MAX_TTL_SECONDS)，当前为 %d", c.Reservation.MaxTTLSeconds))
+	}
+	if c.Reservation.SweepIntervalSeconds < 0 {
+		errs = append(errs, fmt.Errorf("reservation.sweep_interval_seconds 不能为负数 (GODB_RESERVATION_SWEEP_INTERVAL_SECONDS)，当前为 %d", c.Reservation.SweepIntervalSeconds))
+	}
+	if c.Replenishment.IntervalSeconds < 0 {
+		errs = append(errs, fmt.Errorf("replenishment.interval_seconds 不能为负数 (GODB_REPLENISHMENT_INTERVAL_SECONDS)，当前为 %d", c.Replenishment.IntervalSeconds))
+	}
+	switch c.Purchasing.SupplierPolicy {
+	case services.SupplierPolicyCheapest, services.SupplierPolicyMostStock, services.SupplierPolicyPreferred:
+	default:
+		errs = append(errs, fmt.Errorf("purchasing.supplier_policy 必须是 cheapest、most_stock 或 preferred (GODB_PURCHASING_SUPPLIER_POLICY)，当前为 %q", c.Purchasing.SupplierPolicy))
+	}
+	return errors.Join(errs...)
+}
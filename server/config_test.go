@@ -0,0 +1,182 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/notification"
+	"github.com/GoldenStain/goDB/services"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeConfig 在临时目录写入配置文件并返回路径
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	return path
+}
+
+const testConfig = `{
+	"db": {"host": "localhost", "port": 3306, "user": "root", "password": "secret", "dbname": "library"},
+	"threshold": 50
+}`
+
+func TestConfigPath(t *testing.T) {
+	t.Setenv(configPathEnv, "")
+	dir, _ := os.Getwd()
+
+	// 默认路径
+	path, err := ConfigPath("")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "server", "config.json"), path)
+
+	// 环境变量
+	t.Setenv(configPathEnv, "/etc/godb/config.json")
+	path, err = ConfigPath("")
+	assert.NoError(t, err)
+	assert.Equal(t, "/etc/godb/config.json", path)
+
+	// 命令行参数优先
+	path, err = ConfigPath("custom.json")
+	assert.NoError(t, err)
+	assert.Equal(t, "custom.json", path)
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := writeConfig(t, testConfig)
+
+	// 默认值
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", config.DB.Host)
+	assert.Equal(t, defaultListenAddr, config.Server.ListenAddr)
+	assert.Equal(t, defaultLogLevel, config.LogLevel)
+
+	// 环境变量覆盖配置文件
+	t.Setenv("GODB_DB_HOST", "db.internal")
+	t.Setenv("GODB_DB_PORT", "3307")
+	t.Setenv("GODB_THRESHOLD", "70")
+	t.Setenv("GODB_LISTEN_ADDR", "127.0.0.1:6000")
+	t.Setenv("GODB_LOG_LEVEL", "info")
+	config, err = LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "db.internal", config.DB.Host)
+	assert.Equal(t, 3307, config.DB.Port)
+	assert.Equal(t, int32(70), config.Threshold)
+	assert.Equal(t, "127.0.0.1:6000", config.Server.ListenAddr)
+	assert.Equal(t, "info", config.LogLevel)
+
+	// 环境变量不是整数
+	t.Setenv("GODB_DB_PORT", "abc")
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "GODB_DB_PORT")
+}
+
+func TestLoadConfigValidation(t *testing.T) {
+	path := writeConfig(t, testConfig)
+
+	// 所有错误一起返回
+	t.Setenv("GODB_DB_HOST", "")
+	t.Setenv("GODB_THRESHOLD", "150")
+	t.Setenv("GODB_LISTEN_ADDR", "50051")
+	t.Setenv("GODB_TLS_CERT_FILE", "cert.pem")
+	t.Setenv("GODB_LOG_LEVEL", "verbose")
+	_, err := LoadConfig(path)
+	assert.ErrorContains(t, err, "db.host")
+	assert.ErrorContains(t, err, "threshold")
+	assert.ErrorContains(t, err, "server.listen_addr")
+	assert.ErrorContains(t, err, "tls_key_file")
+	assert.ErrorContains(t, err, "log_level")
+
+	// 配置文件不存在
+	_, err = LoadConfig(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestNotificationConfig(t *testing.T) {
+	path := writeConfig(t, testConfig)
+
+	// 默认输出到日志
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, SenderLog, config.Notification.Sender)
+	assert.Equal(t, 587, config.Notification.SMTP.Port)
+	sender, err := newSender(config)
+	assert.NoError(t, err)
+	assert.IsType(t, &notification.LogSender{}, sender)
+
+	// SMTP 需要服务器地址和发件人
+	t.Setenv("GODB_NOTIFICATION_SENDER", SenderSMTP)
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "notification.smtp.host")
+	assert.ErrorContains(t, err, "notification.smtp.from")
+	t.Setenv("GODB_SMTP_HOST", "smtp.example.com")
+	t.Setenv("GODB_SMTP_FROM", "store@example.com")
+	config, err = LoadConfig(path)
+	assert.NoError(t, err)
+	sender, err = newSender(config)
+	assert.NoError(t, err)
+	assert.IsType(t, &notification.SMTPSender{}, sender.(notification.Router)[models.NotificationChannelEmail])
+
+	// 不支持的发送方式
+	t.Setenv("GODB_NOTIFICATION_SENDER", "sms")
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "notification.sender")
+}
+
+func TestReservationConfig(t *testing.T) {
+	path := writeConfig(t, testConfig)
+
+	// 默认保留 15 分钟，最长 1 小时
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 900, config.Reservation.TTLSeconds)
+	assert.Equal(t, 3600, config.Reservation.MaxTTLSeconds)
+	assert.Equal(t, 60, config.Reservation.SweepIntervalSeconds)
+
+	// 默认保留时间不能超过最长时间
+	t.Setenv("GODB_RESERVATION_TTL_SECONDS", "7200")
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "reservation.max_ttl_seconds")
+
+	t.Setenv("GODB_RESERVATION_TTL_SECONDS", "-1")
+	t.Setenv("GODB_RESERVATION_SWEEP_INTERVAL_SECONDS", "-1")
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "reservation.ttl_seconds")
+	assert.ErrorContains(t, err, "reservation.sweep_interval_seconds")
+}
+
+func TestReplenishmentConfig(t *testing.T) {
+	path := writeConfig(t, testConfig)
+
+	// 默认每 5 分钟检查一次
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 300, config.Replenishment.IntervalSeconds)
+
+	t.Setenv("GODB_REPLENISHMENT_INTERVAL_SECONDS", "-1")
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "replenishment.interval_seconds")
+}
+
+func TestPurchasingConfig(t *testing.T) {
+	path := writeConfig(t, testConfig)
+
+	// 默认选择单价最低的供应商
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, services.SupplierPolicyCheapest, config.Purchasing.SupplierPolicy)
+
+	t.Setenv("GODB_PURCHASING_SUPPLIER_POLICY", "preferred")
+	config, err = LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, services.SupplierPolicyPreferred, config.Purchasing.SupplierPolicy)
+
+	t.Setenv("GODB_PURCHASING_SUPPLIER_POLICY", "fastest")
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "purchasing.supplier_policy")
+}
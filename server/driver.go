@@ -0,0 +1,56 @@
+package server
+
+import (
+	"fmt"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// 支持的数据库驱动
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// 各驱动的默认端口
+var defaultPorts = map[string]int{
+	DriverMySQL:    3306,
+	DriverPostgres: 5432,
+}
+
+// dialector 按配置的驱动构建 DSN 并返回对应的 gorm 方言
+func dialector(config *Config) (gorm.Dialector, error) {
+	switch config.DB.Driver {
+	case DriverMySQL:
+		return mysql.Open(mysqlDSN(config)), nil
+	case DriverPostgres:
+		return postgres.Open(postgresDSN(config)), nil
+	case DriverSQLite:
+		return sqlite.Open(sqliteDSN(config)), nil
+	default:
+		return nil, fmt.Errorf("不支持的数据库驱动: %q", config.DB.Driver)
+	}
+}
+
+// mysqlDSN 构建 MySQL 的 DSN
+func mysqlDSN(config *Config) string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Asia%%2FShanghai",
+		config.DB.User, config.DB.Password, config.DB.Host, config.DB.Port, config.DB.DBName)
+}
+
+// postgresDSN 构建 PostgreSQL 的 DSN
+func postgresDSN(config *Config) string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s TimeZone=Asia/Shanghai",
+		config.DB.Host, config.DB.Port, config.DB.User, config.DB.Password, config.DB.DBName, config.DB.SSLMode)
+}
+
+// sqliteDSN 构建 SQLite 的 DSN，开启外键约束，并在写锁冲突时等待而不是立即失败。
+// SQLite 不支持 SELECT ... FOR UPDATE，事务开始时就获取写锁，先读后写的事务才能依次执行，
+// 否则两个事务都读取之后升级写锁时会直接返回 SQLITE_BUSY
+func sqliteDSN(config *Config) string {
+	return fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=5000&_txlock=immediate", config.DB.Path)
+}
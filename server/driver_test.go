@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/migrations"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/services"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestDialector(t *testing.T) {
+	path := writeConfig(t, testConfig)
+
+	// 默认使用 MySQL
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, DriverMySQL, config.DB.Driver)
+	assert.Equal(t, "root:secret@tcp(localhost:3306)/library?charset=utf8mb4&parseTime=True&loc=Asia%2FShanghai", mysqlDSN(config))
+
+	// PostgreSQL 使用默认端口和 sslmode
+	t.Setenv("GODB_DB_DRIVER", DriverPostgres)
+	t.Setenv("GODB_DB_PORT", "0")
+	config, err = LoadConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "host=localhost port=5432 user=root password=secret dbname=library sslmode=disable TimeZone=Asia/Shanghai", postgresDSN(config))
+
+	// SQLite 需要文件路径
+	t.Setenv("GODB_DB_DRIVER", DriverSQLite)
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "db.path")
+
+	// 不支持的驱动
+	t.Setenv("GODB_DB_DRIVER", "oracle")
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "db.driver")
+}
+
+func TestSQLiteDriver(t *testing.T) {
+	t.Setenv("GODB_DB_DRIVER", DriverSQLite)
+	t.Setenv("GODB_DB_PATH", filepath.Join(t.TempDir(), "library.db"))
+	config, err := LoadConfig(writeConfig(t, `{"threshold": 50}`))
+	assert.NoError(t, err)
+
+	// 在 SQLite 文件上完成全部迁移
+	d, err := dialector(config)
+	assert.NoError(t, err)
+	db, err := gorm.Open(d, &gorm.Config{TranslateError: true})
+	assert.NoError(t, err)
+	assert.NoError(t, migrations.Up(db))
+	assert.True(t, db.Migrator().HasTable(&models.CustomerOrderItem{}))
+}
+
+func TestSQLiteConcurrentOrders(t *testing.T) {
+	t.Setenv("GODB_DB_DRIVER", DriverSQLite)
+	t.Setenv("GODB_DB_PATH", filepath.Join(t.TempDir(), "library.db"))
+	config, err := LoadConfig(writeConfig(t, `{"threshold": 50}`))
+	assert.NoError(t, err)
+	d, err := dialector(config)
+	assert.NoError(t, err)
+	db, err := gorm.Open(d, &gorm.Config{TranslateError: true})
+	assert.NoError(t, err)
+	assert.NoError(t, migrations.Up(db))
+
+	db.Create(&models.Customer{OnlineID: "customer1", Name: "Customer 1", Address: "Address 1", AccountBalance: 10000})
+	db.Create(&models.Book{BookNo: "B001", Title: "Book 1", StockQuantity: 5})
+	orderServer := services.NewCustomerOrderServiceServer(db)
+
+	// 并发下单时事务依次执行，不会因为升级写锁失败，也不会超卖
+	var wg sync.WaitGroup
+	codesCh := make(chan codes.Code, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := orderServer.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+				OrderDate: "2023-01-01", CustomerOnlineId: "customer1", BookNo: "B001", BookCount: 1, Price: 100, Address: "Address 1",
+			})
+			codesCh <- status.Code(err)
+		}()
+	}
+	wg.Wait()
+	close(codesCh)
+
+	counts := map[codes.Code]int{}
+	for code := range codesCh {
+		counts[code]++
+	}
+	assert.Equal(t, map[codes.Code]int{codes.OK: 5, codes.FailedPrecondition: 5}, counts)
+	var book models.Book
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(0), book.StockQuantity)
+	var customer models.Customer
+	db.First(&customer, "online_id = ?", "customer1")
+	assert.Equal(t, int32(9500), customer.AccountBalance)
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/GoldenStain/goDB/migrations"
+)
+
+// MigrateUsage 是 migrate 命令的用法
+const MigrateUsage = "用法: godb [-config 配置文件] migrate up|down [步数]|status"
+
+// RunMigrate 执行 migrate 命令：up 执行所有未执行的迁移，down 撤销最近的迁移（默认 1 个），status 列出迁移状态
+func RunMigrate(config *Config, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("缺少 migrate 子命令\n%s", MigrateUsage)
+	}
+
+	// 根据子命令验证参数
+	steps := 1
+	switch args[0] {
+	case "up", "status":
+		if len(args) > 1 {
+			return fmt.Errorf("migrate %s 不接受参数\n%s", args[0], MigrateUsage)
+		}
+	case "down":
+		if len(args) > 2 {
+			return fmt.Errorf("migrate down 最多接受一个参数\n%s", MigrateUsage)
+		}
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("步数必须是正整数: %q", args[1])
+			}
+			steps = n
+		}
+	default:
+		return fmt.Errorf("未知的 migrate 子命令 %q\n%s", args[0], MigrateUsage)
+	}
+
+	db, err := initDB(config)
+	if err != nil {
+		return fmt.Errorf("无法连接到数据库: %w", err)
+	}
+
+	switch args[0] {
+	case "up":
+		return migrations.Up(db)
+	case "down":
+		return migrations.Down(db, steps)
+	default:
+		statuses, err := migrations.Status(db)
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.Applied {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(out, "%04d  %-32s %s\n", status.Version, status.Name, appliedAt)
+		}
+		return err
+	}
+}
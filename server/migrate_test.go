@@ -0,0 +1,34 @@
+package server
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunMigrate(t *testing.T) {
+	t.Setenv("GODB_DB_DRIVER", DriverSQLite)
+	t.Setenv("GODB_DB_PATH", filepath.Join(t.TempDir(), "library.db"))
+	config, err := LoadConfig(writeConfig(t, `{"threshold": 50}`))
+	assert.NoError(t, err)
+
+	// 参数错误
+	var out bytes.Buffer
+	assert.ErrorContains(t, RunMigrate(config, nil, &out), "缺少 migrate 子命令")
+	assert.ErrorContains(t, RunMigrate(config, []string{"sideways"}, &out), "未知的 migrate 子命令")
+	assert.ErrorContains(t, RunMigrate(config, []string{"down", "0"}, &out), "步数必须是正整数")
+
+	// 执行迁移后所有迁移都已执行
+	assert.NoError(t, RunMigrate(config, []string{"up"}, &out))
+	assert.NoError(t, RunMigrate(config, []string{"status"}, &out))
+	assert.Contains(t, out.String(), "0001  baseline")
+	assert.NotContains(t, out.String(), "pending")
+
+	// 撤销最近一个迁移
+	out.Reset()
+	assert.NoError(t, RunMigrate(config, []string{"down"}, &out))
+	assert.NoError(t, RunMigrate(config, []string{"status"}, &out))
+	assert.Contains(t, out.String(), "pending")
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/notification"
+	"gorm.io/gorm"
+)
+
+// newSender 根据配置创建通知的发送方式，file 和 log 方式接收所有渠道的通知
+func newSender(config *Config) (notification.Sender, error) {
+	switch config.Notification.Sender {
+	case SenderSMTP:
+		// SMTP 只能发送邮件，其他渠道的通知标记为发送失败
+		smtp := config.Notification.SMTP
+		addr := net.JoinHostPort(smtp.Host, strconv.Itoa(smtp.Port))
+		return notification.Router{
+			models.NotificationChannelEmail: notification.NewSMTPSender(addr, smtp.From, smtp.Username, smtp.Password),
+		}, nil
+	case SenderFile:
+		return notification.NewFileSender(config.Notification.Dir), nil
+	case SenderLog:
+		return notification.NewLogSender(nil), nil
+	default:
+		return nil, fmt.Errorf("未知的通知发送方式 %q", config.Notification.Sender)
+	}
+}
+
+// startNotificationDispatcher 在后台定期发送发件箱中的通知，直到 ctx 被取消
+func startNotificationDispatcher(ctx context.Context, db *gorm.DB, config *Config) error {
+	sender, err := newSender(config)
+	if err != nil {
+		return err
+	}
+	dispatcher := notification.NewDispatcher(db, sender)
+	dispatcher.MaxAttempts = int32(config.Notification.MaxAttempts)
+	go dispatcher.Run(ctx, time.Duration(config.Notification.IntervalSeconds)*time.Second)
+	return nil
+}
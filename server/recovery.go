@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoverUnary 把一元调用处理函数中的 panic 转为 Internal 错误，避免一个请求让整个服务器退出
+func recoverUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// recoverStream 把流式调用处理函数中的 panic 转为 Internal 错误
+func recoverStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(info.FullMethod, r)
+		}
+	}()
+	return handler(srv, stream)
+}
+
+// recoveredError 记录 panic 和调用栈，返回给客户端的错误不包含内部细节
+func recoveredError(method string, r interface{}) error {
+	log.Printf("处理 %s 时发生 panic: %v\n%s", method, r, debug.Stack())
+	return status.Error(codes.Internal, "Internal server error")
+}
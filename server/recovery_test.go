@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoverUnary(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Panic"}
+
+	// panic 转为 Internal 错误
+	_, err := recoverUnary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		var levels []int
+		return levels[3], nil
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// 正常的应答和错误原样返回
+	resp, err := recoverUnary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	_, err = recoverUnary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
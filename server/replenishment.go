@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/GoldenStain/goDB/services"
+	"gorm.io/gorm"
+)
+
+// startReplenishmentScheduler 在后台定期为低于补货点的书籍创建缺书登记，直到 ctx 被取消
+func startReplenishmentScheduler(ctx context.Context, db *gorm.DB, config *Config) {
+	interval := time.Duration(config.Replenishment.IntervalSeconds) * time.Second
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if _, err := services.CreateReorderStockRequests(ctx, db); err != nil && ctx.Err() == nil {
+				log.Printf("自动补货失败: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/GoldenStain/goDB/services"
+	"gorm.io/gorm"
+)
+
+// startReservationSweeper 在后台定期释放过期的库存预留，直到 ctx 被取消
+func startReservationSweeper(ctx context.Context, db *gorm.DB, config *Config) {
+	interval := time.Duration(config.Reservation.SweepIntervalSeconds) * time.Second
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if _, err := services.ReleaseExpiredReservations(ctx, db, time.Now()); err != nil && ctx.Err() == nil {
+				log.Printf("释放过期的库存预留失败: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -1,76 +1,106 @@
 package server
 
 import (
-	"encoding/json"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"fmt"
 	"log"
 	"net"
-	"os"
+	"time"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 
-	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/migrations"
 	"github.com/GoldenStain/goDB/services"
 	"google.golang.org/grpc"
-	"gorm.io/driver/mysql"
+	"google.golang.org/grpc/credentials"
 	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 var matchThreshold int32
 
-// Config 用来读取配置文件中的数据
-type Config struct {
-	DB struct {
-		Host     string `json:"host"`
-		Port     int    `json:"port"`
-		User     string `json:"user"`
-		Password string `json:"password"`
-		DBName   string `json:"dbname"`
-	} `json:"db"`
-	Threshold int32 `json:"threshold"`
-}
+var tokenSigner *services.TokenSigner
+
+// 初始化数据库连接
+func initDB(config *Config) (*gorm.DB, error) {
+	matchThreshold = config.Threshold
 
-// 读取配置文件
-func loadConfig(file string) (*Config, error) {
-	var config Config
-	data, err := os.ReadFile(file)
+	dialector, err := dialector(config)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(data, &config)
+	// 开启错误转换，唯一索引冲突会被转换为 gorm.ErrDuplicatedKey
+	db, err := gorm.Open(dialector, &gorm.Config{
+		TranslateError: true,
+		Logger:         logger.Default.LogMode(gormLogLevel(config.LogLevel)),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &config, nil
+	return db, nil
 }
 
-// 初始化数据库连接
-func initDB(config *Config) (*gorm.DB, error) {
-	matchThreshold = config.Threshold
+// gormLogLevel 把配置中的日志级别转换为 gorm 的日志级别
+func gormLogLevel(level string) logger.LogLevel {
+	switch level {
+	case "silent":
+		return logger.Silent
+	case "error":
+		return logger.Error
+	case "info":
+		return logger.Info
+	default:
+		return logger.Warn
+	}
+}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Asia%%2FShanghai",
-		config.DB.User, config.DB.Password, config.DB.Host, config.DB.Port, config.DB.DBName)
+// 初始化令牌签发
+func initAuth(config *Config) error {
+	secret := []byte(config.Auth.Secret)
+	if len(secret) == 0 {
+		log.Println("未配置令牌签名密钥，使用随机密钥")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return err
+		}
+	}
 
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
-	if err != nil {
-		return nil, err
+	ttl := time.Duration(config.Auth.TokenTTLMinutes) * time.Minute
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
 	}
+	tokenSigner = services.NewTokenSigner(secret, ttl)
 
-	return db, nil
+	// 分页游标使用由同一密钥派生的独立密钥，避免游标被当作登录令牌
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("page_token"))
+	services.SetPageTokenSecret(mac.Sum(nil))
+	return nil
 }
 
-func ConnectDB() *gorm.DB {
-	// 加载配置文件
-	dir, err := os.Getwd()
+// LoadConfigFromFlag 按命令行参数、环境变量和默认路径的顺序找到配置文件并加载
+func LoadConfigFromFlag(flagPath string) *Config {
+	path, err := ConfigPath(flagPath)
 	if err != nil {
-		log.Fatal("无法读取main.go位置！")
+		log.Fatal(err)
 	}
-	config, err := loadConfig(fmt.Sprintf("%s\\server\\config.json", dir))
+	config, err := LoadConfig(path)
 	if err != nil {
 		log.Fatalf("无法加载配置文件: %v", err)
 	}
+	return config
+}
+
+func ConnectDB(config *Config) *gorm.DB {
+	// 初始化令牌签发
+	if err := initAuth(config); err != nil {
+		log.Fatalf("无法初始化令牌签名密钥: %v", err)
+	}
 
 	// 初始化数据库连接
 	db, err := initDB(config)
@@ -80,25 +110,52 @@ func ConnectDB() *gorm.DB {
 
 	fmt.Println("数据库连接成功:", db)
 
-	// 自动迁移
-	err = models.AutoMigrate(db)
+	// 执行尚未执行的版本迁移
+	err = migrations.Up(db)
 	if err != nil {
 		log.Fatalf("无法迁移数据库: %v", err)
 	}
+
+	// 核对库存变动记录，不一致时只记录日志，不影响启动
+	discrepancies, err := services.ReconcileStock(context.Background(), db)
+	if err != nil {
+		log.Printf("无法核对库存: %v", err)
+	}
+	for _, d := range discrepancies {
+		log.Printf("书籍 %s 的在库数量为 %d，库存变动之和为 %d", d.BookNo, d.StockQuantity, d.MovementTotal)
+	}
+
+	// 初始化管理员账号
+	if config.Auth.AdminOnlineID != "" {
+		if err := services.EnsureAdminAccount(db, config.Auth.AdminOnlineID, config.Auth.AdminPassword); err != nil {
+			log.Fatalf("无法初始化管理员账号: %v", err)
+		}
+	}
 	return db
 }
 
-func registerRpcServices(gServer *grpc.Server, db *gorm.DB) {
+func registerRpcServices(gServer *grpc.Server, db *gorm.DB, config *Config) {
 	// 书籍
 	bookService := services.NewBookServiceServer(db)
+	bookService.ReservationTTL = time.Duration(config.Reservation.TTLSeconds) * time.Second
+	bookService.MaxReservationTTL = time.Duration(config.Reservation.MaxTTLSeconds) * time.Second
 	pb.RegisterBookServiceServer(gServer, bookService)
 
+	// 出版社
+	publisherService := services.NewPublisherServiceServer(db)
+	pb.RegisterPublisherServiceServer(gServer, publisherService)
+
+	// 丛书
+	seriesService := services.NewSeriesServiceServer(db)
+	pb.RegisterSeriesServiceServer(gServer, seriesService)
+
 	// 缺书登记
 	stockRequestService := services.NewStockRequestServiceServer(db)
 	pb.RegisterStockRequestServiceServer(gServer, stockRequestService)
 
 	// 采购单
 	purchaseOrderService := services.NewPurchaseOrderServiceServer(db)
+	purchaseOrderService.SupplierPolicy = config.Purchasing.SupplierPolicy
 	pb.RegisterPurchaseOrderServiceServer(gServer, purchaseOrderService)
 
 	// 客户
@@ -117,18 +174,52 @@ func registerRpcServices(gServer *grpc.Server, db *gorm.DB) {
 	supplyBookService := services.NewSupplyBookServiceServer(db)
 	pb.RegisterSupplyBookServiceServer(gServer, supplyBookService)
 
+	// 需求预测
+	forecastService := services.NewForecastServiceServer(db)
+	pb.RegisterForecastServiceServer(gServer, forecastService)
+
 	// 网上查询服务
 	onlineService := services.NewOnlineServiceServer(db, matchThreshold)
 	pb.RegisterOnlineServiceServer(gServer, onlineService)
+
+	// 登录认证
+	authService := services.NewAuthServiceServer(db, tokenSigner)
+	pb.RegisterAuthServiceServer(gServer, authService)
 }
 
-func StartServer(db *gorm.DB) {
-	lis, err := net.Listen("tcp", ":50051")
+func StartServer(db *gorm.DB, config *Config) {
+	lis, err := net.Listen("tcp", config.Server.ListenAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	grpcServer := grpc.NewServer()
-	registerRpcServices(grpcServer, db)
+	// 处理函数 panic 时返回错误而不是退出，然后验证令牌并按角色检查调用权限
+	authInterceptor := services.NewAuthInterceptor(tokenSigner)
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(recoverUnary, authInterceptor.Unary),
+		grpc.ChainStreamInterceptor(recoverStream, authInterceptor.Stream),
+	}
+
+	// 配置了证书时启用 TLS
+	if config.Server.TLSCertFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(config.Server.TLSCertFile, config.Server.TLSKeyFile)
+		if err != nil {
+			log.Fatalf("failed to load TLS credentials: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	registerRpcServices(grpcServer, db, config)
+
+	// 在后台发送通知
+	if err := startNotificationDispatcher(context.Background(), db, config); err != nil {
+		log.Fatalf("failed to start notification dispatcher: %v", err)
+	}
+
+	// 在后台释放过期的库存预留
+	startReservationSweeper(context.Background(), db, config)
+
+	// 在后台为低于补货点的书籍创建缺书登记
+	startReplenishmentScheduler(context.Background(), db, config)
 
 	log.Printf("server listening at %v", lis.Addr())
 	if err := grpcServer.Serve(lis); err != nil {
@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 令牌中的角色，管理员可以调用所有方法
+const (
+	RoleCustomer   = "customer"
+	RoleClerk      = "clerk"
+	RolePurchasing = "purchasing"
+	RoleAdmin      = "admin"
+)
+
+// 所有角色
+var validRoles = map[string]bool{
+	RoleCustomer:   true,
+	RoleClerk:      true,
+	RolePurchasing: true,
+	RoleAdmin:      true,
+}
+
+// publicMethods 不需要登录就可以调用的方法
+var publicMethods = map[string]bool{
+	pb.AuthService_Login_FullMethodName:              true,
+	pb.CustomerService_CreateCustomer_FullMethodName: true,
+	pb.BookService_GetBook_FullMethodName:            true,
+	pb.PublisherService_GetPublisher_FullMethodName:  true,
+	pb.SeriesService_GetSeries_FullMethodName:        true,
+	pb.OnlineService_QueryBook_FullMethodName:        true,
+}
+
+// methodRoles 每个方法允许调用的角色，未列出的方法只有管理员可以调用
+var methodRoles = map[string][]string{
+	// 书籍、出版社和丛书由店员维护
+	pb.BookService_CreateBook_FullMethodName:           {RoleClerk},
+	pb.BookService_UpdateBook_FullMethodName:           {RoleClerk},
+	pb.BookService_DeleteBook_FullMethodName:           {RoleClerk},
+	pb.PublisherService_CreatePublisher_FullMethodName: {RoleClerk},
+	pb.PublisherService_UpdatePublisher_FullMethodName: {RoleClerk},
+	pb.PublisherService_DeletePublisher_FullMethodName: {RoleClerk},
+	pb.SeriesService_CreateSeries_FullMethodName:       {RoleClerk},
+	pb.SeriesService_UpdateSeries_FullMethodName:       {RoleClerk},
+	pb.SeriesService_DeleteSeries_FullMethodName:       {RoleClerk},
+	pb.BookService_StreamBooks_FullMethodName:          {RoleClerk, RolePurchasing},
+	pb.BookService_GetStockHistory_FullMethodName:      {RoleClerk, RolePurchasing},
+
+	// 缺书登记由店员登记、采购员处理
+	pb.StockRequestService_CreateStockRequest_FullMethodName: {RoleClerk, RolePurchasing},
+	pb.StockRequestService_GetStockRequest_FullMethodName:    {RoleClerk, RolePurchasing},
+	pb.StockRequestService_UpdateStockRequest_FullMethodName: {RoleClerk, RolePurchasing},
+	pb.StockRequestService_DeleteStockRequest_FullMethodName: {RolePurchasing},
+
+	// 采购、供应商、供书单和需求预测由采购员维护
+	pb.PurchaseOrderService_CreatePurchaseOrder_FullMethodName:                     {RolePurchasing},
+	pb.PurchaseOrderService_GetPurchaseOrder_FullMethodName:                        {RolePurchasing},
+	pb.PurchaseOrderService_UpdatePurchaseOrder_FullMethodName:                     {RolePurchasing},
+	pb.PurchaseOrderService_DeletePurchaseOrder_FullMethodName:                     {RolePurchasing},
+	pb.PurchaseOrderService_GeneratePurchaseOrdersFromStockRequests_FullMethodName: {RolePurchasing},
+	pb.PurchaseOrderService_StreamPurchaseOrders_FullMethodName:                    {RolePurchasing},
+	pb.PurchaseOrderService_ReceivePurchaseOrder_FullMethodName:                    {RolePurchasing},
+	pb.SupplierService_CreateSupplier_FullMethodName:                               {RolePurchasing},
+	pb.SupplierService_GetSupplier_FullMethodName:                                  {RolePurchasing},
+	pb.SupplierService_UpdateSupplier_FullMethodName:                               {RolePurchasing},
+	pb.SupplierService_DeleteSupplier_FullMethodName:                               {RolePurchasing},
+	pb.SupplyBookService_CreateSupplyBook_FullMethodName:                           {RolePurchasing},
+	pb.SupplyBookService_GetSupplyBooksBySupplier_FullMethodName:                   {RolePurchasing},
+	pb.SupplyBookService_GetSupplyBookByID_FullMethodName:                          {RolePurchasing},
+	pb.SupplyBookService_UpdateSupplyBook_FullMethodName:                           {RolePurchasing},
+	pb.SupplyBookService_DeleteSupplyBook_FullMethodName:                           {RolePurchasing},
+	pb.ForecastService_ForecastDemand_FullMethodName:                               {RolePurchasing},
+
+	// 客户只能查看和修改自己的资料，删除客户只有管理员可以调用
+	pb.CustomerService_GetCustomer_FullMethodName:    {RoleCustomer, RoleClerk},
+	pb.CustomerService_UpdateCustomer_FullMethodName: {RoleCustomer},
+	pb.OnlineService_QueryCustomer_FullMethodName:    {RoleClerk},
+
+	// 客户只能查看和修改自己的订单，删除订单只有管理员可以调用
+	pb.CustomerOrderService_CreateCustomerOrder_FullMethodName:  {RoleCustomer, RoleClerk},
+	pb.CustomerOrderService_GetCustomerOrder_FullMethodName:     {RoleCustomer, RoleClerk},
+	pb.CustomerOrderService_UpdateCustomerOrder_FullMethodName:  {RoleCustomer, RoleClerk},
+	pb.CustomerOrderService_CancelCustomerOrder_FullMethodName:  {RoleCustomer, RoleClerk},
+	pb.CustomerOrderService_StreamCustomerOrders_FullMethodName: {RoleCustomer, RoleClerk},
+
+	// 客户只能确认和释放自己的库存预留
+	pb.BookService_ReserveStock_FullMethodName:       {RoleCustomer, RoleClerk},
+	pb.BookService_ConfirmReservation_FullMethodName: {RoleCustomer, RoleClerk},
+	pb.BookService_ReleaseReservation_FullMethodName: {RoleCustomer, RoleClerk},
+}
+
+// callerKey 是调用者信息在 context 中的键
+type callerKey struct{}
+
+// AuthInterceptor 验证请求元数据中的令牌，并检查调用者的角色能否调用目标方法
+type AuthInterceptor struct {
+	signer *TokenSigner
+}
+
+// NewAuthInterceptor 用于创建 AuthInterceptor
+func NewAuthInterceptor(signer *TokenSigner) *AuthInterceptor {
+	return &AuthInterceptor{
+		signer: signer,
+	}
+}
+
+// Unary 是一元调用的拦截器
+func (i *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := i.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream 是流式调用的拦截器
+func (i *AuthInterceptor) Stream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := i.authorize(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authorizedStream 让流式处理函数从 Context 中取得调用者信息
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context 返回带有调用者信息的 context
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize 验证令牌并把调用者信息放入 context，匿名调用公开方法时调用者的角色为空
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	claims := &TokenClaims{}
+	token, hasToken := bearerToken(ctx)
+	if hasToken {
+		verified, err := i.signer.Verify(token)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "Invalid token: %v", err)
+		}
+		claims = verified
+	}
+	ctx = context.WithValue(ctx, callerKey{}, claims)
+
+	if publicMethods[method] {
+		return ctx, nil
+	}
+	if !hasToken {
+		return nil, status.Error(codes.Unauthenticated, "Authorization token is required")
+	}
+	if !roleAllowed(claims.Role, method) {
+		return nil, status.Errorf(codes.PermissionDenied, "Role %q cannot call %s", claims.Role, method)
+	}
+	return ctx, nil
+}
+
+// bearerToken 从请求元数据的 authorization 中读取 Bearer 令牌
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get("authorization") {
+		if token, ok := strings.CutPrefix(value, "Bearer "); ok && token != "" {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+// roleAllowed 判断角色能否调用方法
+func roleAllowed(role, method string) bool {
+	if role == RoleAdmin {
+		return true
+	}
+	for _, allowed := range methodRoles[method] {
+		if allowed == role {
+			return true
+		}
+	}
+	return false
+}
+
+// callerFromContext 返回拦截器放入 context 的调用者信息，没有经过拦截器的调用返回 false
+func callerFromContext(ctx context.Context) (*TokenClaims, bool) {
+	claims, ok := ctx.Value(callerKey{}).(*TokenClaims)
+	return claims, ok
+}
+
+// systemActor 是没有经过拦截器的内部调用在操作记录中的名称
+const systemActor = "system"
+
+// callerActor 返回调用者的在线ID，用于记录是谁做的修改，内部调用返回 systemActor
+func callerActor(ctx context.Context) string {
+	claims, ok := callerFromContext(ctx)
+	if !ok {
+		return systemActor
+	}
+	return claims.OnlineID
+}
+
+// customerScope 调用者是客户时返回其在线ID，用于把查询和修改限制在客户自己的数据上
+func customerScope(ctx context.Context) (string, bool) {
+	claims, ok := callerFromContext(ctx)
+	if !ok || claims.Role != RoleCustomer {
+		return "", false
+	}
+	return claims.OnlineID, true
+}
+
+// checkOrderOwner 调用者是客户时检查订单是否属于该客户
+func checkOrderOwner(ctx context.Context, order *models.CustomerOrder) error {
+	if onlineID, ok := customerScope(ctx); ok && order.CustomerOnlineID != onlineID {
+		return status.Error(codes.PermissionDenied, "Customers can only access their own orders")
+	}
+	return nil
+}
+
+// canManageAccount 判断调用者能否设置客户的余额和信用等级，只有店员、管理员和没有经过拦截器的内部调用可以设置
+func canManageAccount(ctx context.Context) bool {
+	claims, ok := callerFromContext(ctx)
+	return !ok || claims.Role == RoleClerk || claims.Role == RoleAdmin
+}
+
+// canAssignRole 判断调用者能否设置角色，只有管理员和没有经过拦截器的内部调用可以设置
+func canAssignRole(ctx context.Context) bool {
+	claims, ok := callerFromContext(ctx)
+	return !ok || claims.Role == RoleAdmin
+}
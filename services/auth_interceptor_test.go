@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// callerContext 构造经过拦截器后的 context
+func callerContext(onlineID, role string) context.Context {
+	return context.WithValue(context.Background(), callerKey{}, &TokenClaims{OnlineID: onlineID, Role: role})
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	signer := NewTokenSigner([]byte("test-secret"), time.Hour)
+	interceptor := NewAuthInterceptor(signer)
+
+	// 调用拦截器，返回 handler 收到的调用者
+	call := func(method, role string) (*TokenClaims, error) {
+		ctx := context.Background()
+		if role != "" {
+			token, _, err := signer.Sign("user1", role)
+			assert.NoError(t, err)
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		var caller *TokenClaims
+		_, err := interceptor.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			caller, _ = callerFromContext(ctx)
+			return nil, nil
+		})
+		return caller, err
+	}
+
+	// 公开方法不需要令牌
+	caller, err := call(pb.BookService_GetBook_FullMethodName, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "", caller.Role)
+
+	// 其他方法缺少令牌
+	_, err = call(pb.BookService_CreateBook_FullMethodName, "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// 无效的令牌
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer invalid"))
+	_, err = interceptor.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pb.BookService_GetBook_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// 角色不允许调用
+	_, err = call(pb.BookService_CreateBook_FullMethodName, RoleCustomer)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = call(pb.PurchaseOrderService_CreatePurchaseOrder_FullMethodName, RoleClerk)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = call(pb.CustomerService_DeleteCustomer_FullMethodName, RoleClerk)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// 角色允许调用
+	caller, err = call(pb.BookService_CreateBook_FullMethodName, RoleClerk)
+	assert.NoError(t, err)
+	assert.Equal(t, "user1", caller.OnlineID)
+	assert.Equal(t, RoleClerk, caller.Role)
+	_, err = call(pb.PurchaseOrderService_CreatePurchaseOrder_FullMethodName, RolePurchasing)
+	assert.NoError(t, err)
+
+	// 管理员可以调用所有方法
+	_, err = call(pb.CustomerService_DeleteCustomer_FullMethodName, RoleAdmin)
+	assert.NoError(t, err)
+}
+
+func TestCustomerOwnership(t *testing.T) {
+	db := setupTestDB(t)
+	customerServer := NewCustomerServiceServer(db)
+	orderServer := NewCustomerOrderServiceServer(db)
+
+	for _, onlineID := range []string{"customer1", "customer2"} {
+		db.Create(&models.Customer{OnlineID: onlineID, Password: "password", Name: onlineID, Address: "Address", AccountBalance: 1000})
+	}
+	db.Create(&models.Book{BookNo: "B001", Title: "Book Title 1", PublisherName: "Test Publisher", Authors: "Test Author", StockQuantity: 10})
+	customer1 := callerContext("customer1", RoleCustomer)
+
+	// 客户只能查询自己的资料
+	customersResp, err := customerServer.GetCustomer(customer1, &pb.GetCustomerRequest{Start: 0, Stop: 10})
+	assert.NoError(t, err)
+	assert.Len(t, customersResp.GetCustomers(), 1)
+	assert.Equal(t, "customer1", customersResp.GetCustomers()[0].OnlineId)
+	assert.Equal(t, RoleCustomer, customersResp.GetCustomers()[0].Role)
+
+	// 客户只能修改自己的资料，且不能修改余额
+	var customer2 models.Customer
+	db.First(&customer2, "online_id = ?", "customer2")
+	_, err = customerServer.UpdateCustomer(customer1, &pb.UpdateCustomerRequest{Id: customer2.ID, Name: "Changed"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	var self models.Customer
+	db.First(&self, "online_id = ?", "customer1")
+	_, err = customerServer.UpdateCustomer(customer1, &pb.UpdateCustomerRequest{Id: self.ID, AccountBalance: 99999})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = customerServer.UpdateCustomer(customer1, &pb.UpdateCustomerRequest{Id: self.ID, Name: "Changed"})
+	assert.NoError(t, err)
+
+	// 只有管理员可以设置角色
+	_, err = customerServer.UpdateCustomer(customer1, &pb.UpdateCustomerRequest{Id: self.ID, Role: RoleAdmin})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = customerServer.CreateCustomer(callerContext("", ""), &pb.CreateCustomerRequest{OnlineId: "clerk1", Password: "password", Name: "Clerk", Address: "Address", Role: RoleClerk})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = customerServer.CreateCustomer(callerContext("admin", RoleAdmin), &pb.CreateCustomerRequest{OnlineId: "clerk1", Password: "password", Name: "Clerk", Address: "Address", Role: RoleClerk})
+	assert.NoError(t, err)
+	var clerk models.Customer
+	db.First(&clerk, "online_id = ?", "clerk1")
+	assert.Equal(t, RoleClerk, clerk.Role)
+
+	// 自助注册不能设置余额和信用等级，店员可以设置，信用等级超出范围时拒绝
+	anonymous := callerContext("", "")
+	_, err = customerServer.CreateCustomer(anonymous, &pb.CreateCustomerRequest{OnlineId: "rich", Password: "password", Name: "Rich", Address: "Address", AccountBalance: 99999})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = customerServer.CreateCustomer(anonymous, &pb.CreateCustomerRequest{OnlineId: "rich", Password: "password", Name: "Rich", Address: "Address", CreditLevel: 5})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = customerServer.CreateCustomer(callerContext("clerk1", RoleClerk), &pb.CreateCustomerRequest{OnlineId: "rich", Password: "password", Name: "Rich", Address: "Address", CreditLevel: 9})
+	assert.Equal(t, "credit_level", badRequestField(t, err))
+	_, err = customerServer.UpdateCustomer(callerContext("clerk1", RoleClerk), &pb.UpdateCustomerRequest{Id: self.ID, CreditLevel: 6})
+	assert.Equal(t, "credit_level", badRequestField(t, err))
+	_, err = customerServer.CreateCustomer(callerContext("clerk1", RoleClerk), &pb.CreateCustomerRequest{OnlineId: "rich", Password: "password", Name: "Rich", Address: "Address", AccountBalance: 500, CreditLevel: 5})
+	assert.NoError(t, err)
+	_, err = customerServer.CreateCustomer(anonymous, &pb.CreateCustomerRequest{OnlineId: "plain", Password: "password", Name: "Plain", Address: "Address"})
+	assert.NoError(t, err)
+
+	// 客户不能为其他客户下单
+	_, err = orderServer.CreateCustomerOrder(customer1, &pb.CreateCustomerOrderRequest{OrderDate: "2023-01-01", CustomerOnlineId: "customer2", BookNo: "B001", BookCount: 1, Price: 10, Address: "Address"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = orderServer.CreateCustomerOrder(customer1, &pb.CreateCustomerOrderRequest{OrderDate: "2023-01-01", CustomerOnlineId: "customer1", BookNo: "B001", BookCount: 1, Price: 10, Address: "Address"})
+	assert.NoError(t, err)
+	order2, err := orderServer.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{OrderDate: "2023-01-01", CustomerOnlineId: "customer2", BookNo: "B001", BookCount: 1, Price: 10, Address: "Address"})
+	assert.NoError(t, err)
+
+	// 客户只能查询自己的订单
+	ordersResp, err := orderServer.GetCustomerOrder(customer1, &pb.GetCustomerOrderRequest{Start: 0, Stop: 10})
+	assert.NoError(t, err)
+	assert.Len(t, ordersResp.GetCustomerOrders(), 1)
+	assert.Equal(t, "customer1", ordersResp.GetCustomerOrders()[0].CustomerOnlineId)
+
+	// 客户不能修改和取消其他客户的订单，也不能修改自己订单的状态
+	_, err = orderServer.UpdateCustomerOrder(customer1, &pb.UpdateCustomerOrderRequest{Id: order2.GetId(), Address: "Changed"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = orderServer.CancelCustomerOrder(customer1, &pb.CancelCustomerOrderRequest{Id: order2.GetId()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	ownOrderID := ordersResp.GetCustomerOrders()[0].Id
+	_, err = orderServer.UpdateCustomerOrder(customer1, &pb.UpdateCustomerOrderRequest{Id: ownOrderID, OrderStatus: pb.OrderStatus_ORDER_STATUS_SHIPPED})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = orderServer.UpdateCustomerOrder(customer1, &pb.UpdateCustomerOrderRequest{Id: ownOrderID, Address: "Changed"})
+	assert.NoError(t, err)
+	_, err = orderServer.CancelCustomerOrder(customer1, &pb.CancelCustomerOrderRequest{Id: ownOrderID})
+	assert.NoError(t, err)
+}
+
+func TestEnsureAdminAccount(t *testing.T) {
+	db := setupTestDB(t)
+
+	// 不存在时创建
+	assert.NoError(t, EnsureAdminAccount(db, "admin", "secret"))
+	var admin models.Customer
+	db.First(&admin, "online_id = ?", "admin")
+	assert.Equal(t, RoleAdmin, admin.Role)
+	match, hashed := checkPassword(admin.Password, "secret")
+	assert.True(t, match)
+	assert.True(t, hashed)
+
+	// 已经是管理员时不做修改
+	assert.NoError(t, EnsureAdminAccount(db, "admin", "other"))
+	db.First(&admin, "online_id = ?", "admin")
+	match, _ = checkPassword(admin.Password, "secret")
+	assert.True(t, match)
+
+	// 账号被普通用户占用时返回错误，不提升为管理员
+	db.Create(&models.Customer{OnlineID: "boss", Password: "password", Name: "Boss", Address: "Address"})
+	assert.Error(t, EnsureAdminAccount(db, "boss", "secret"))
+	var boss models.Customer
+	db.First(&boss, "online_id = ?", "boss")
+	assert.Equal(t, RoleCustomer, boss.Role)
+	assert.Equal(t, "password", boss.Password)
+}
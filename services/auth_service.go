@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var errInvalidCredentials = status.Error(codes.Unauthenticated, "Invalid online ID or password")
+
+type AuthServiceServer struct {
+	pb.UnimplementedAuthServiceServer
+	db     *gorm.DB
+	signer *TokenSigner
+}
+
+// NewAuthServiceServer 用于创建 AuthServiceServer
+func NewAuthServiceServer(db *gorm.DB, signer *TokenSigner) *AuthServiceServer {
+	return &AuthServiceServer{
+		db:     db,
+		signer: signer,
+	}
+}
+
+// Login 验证客户的在线ID和密码并签发令牌，旧的明文密码在第一次登录成功后改为哈希保存
+func (s *AuthServiceServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	// 验证用户输入
+	if req.GetOnlineId() == "" {
+		return nil, invalidArgumentError("online_id", "Online ID is required")
+	}
+
+	if req.GetPassword() == "" {
+		return nil, invalidArgumentError("password", "Password is required")
+	}
+
+	// 查询客户，虚拟客户不能登录
+	var customer models.Customer
+	if err := s.db.Where("online_id = ? AND name != ?", req.GetOnlineId(), "virtual").First(&customer).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errInvalidCredentials
+		}
+		return nil, status.Errorf(codes.Internal, "failed to query customer: %v", err)
+	}
+
+	// 验证密码
+	match, hashed := checkPassword(customer.Password, req.GetPassword())
+	if !match {
+		return nil, errInvalidCredentials
+	}
+
+	// 明文密码改为哈希保存
+	if !hashed {
+		hash, err := hashPassword(req.GetPassword())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+		}
+		if err := s.db.Model(&customer).Update("password", hash).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update password: %v", err)
+		}
+	}
+
+	// 签发令牌
+	token, expiresAt, err := s.signer.Sign(customer.OnlineID, customer.Role)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign token: %v", err)
+	}
+
+	// 返回成功的响应
+	return &pb.LoginResponse{
+		Success:   true,
+		Feedback:  "Login successful",
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// hashPassword 使用 bcrypt 计算密码哈希
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// checkPassword 验证密码，hashed 表示数据库中保存的是否已经是哈希
+func checkPassword(stored, password string) (match bool, hashed bool) {
+	if _, err := bcrypt.Cost([]byte(stored)); err == nil {
+		return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil, true
+	}
+	// 兼容尚未迁移的明文密码
+	return stored != "" && subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1, false
+}
+
+// EnsureAdminAccount 确保管理员账号存在，不存在时用给定的密码创建，
+// 账号已被普通用户占用时返回错误，不会把已有的账号提升为管理员
+func EnsureAdminAccount(db *gorm.DB, onlineID, password string) error {
+	var customer models.Customer
+	err := db.Where("online_id = ?", onlineID).First(&customer).Error
+	if err == nil {
+		if customer.Role == RoleAdmin {
+			return nil
+		}
+		return fmt.Errorf("online id %q is already used by a %s account", onlineID, customer.Role)
+	}
+	if err != gorm.ErrRecordNotFound {
+		return err
+	}
+
+	if password == "" {
+		return errors.New("admin password is required to create the admin account")
+	}
+	passwordHash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	return db.Create(&models.Customer{
+		OnlineID:  onlineID,
+		Password:  passwordHash,
+		Role:      RoleAdmin,
+		Name:      onlineID,
+		Address:   "",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}).Error
+}
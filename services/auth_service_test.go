@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLogin(t *testing.T) {
+	db := setupTestDB(t)
+	customerServer := NewCustomerServiceServer(db)
+	signer := NewTokenSigner([]byte("test-secret"), time.Hour)
+	server := NewAuthServiceServer(db, signer)
+
+	// 通过 CreateCustomer 创建的客户
+	_, err := customerServer.CreateCustomer(context.Background(), &pb.CreateCustomerRequest{
+		OnlineId: "customer1",
+		Password: "password",
+		Name:     "Customer 1",
+		Address:  "Address 1",
+	})
+	assert.NoError(t, err)
+
+	resp, err := server.Login(context.Background(), &pb.LoginRequest{OnlineId: "customer1", Password: "password"})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	claims, err := signer.Verify(resp.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, "customer1", claims.OnlineID)
+	assert.Equal(t, RoleCustomer, claims.Role)
+	assert.Equal(t, resp.ExpiresAt.AsTime().Unix(), claims.ExpiresAt)
+
+	// 密码错误
+	_, err = server.Login(context.Background(), &pb.LoginRequest{OnlineId: "customer1", Password: "wrong"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// 客户不存在
+	_, err = server.Login(context.Background(), &pb.LoginRequest{OnlineId: "nobody", Password: "password"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// 虚拟客户不能登录
+	_, err = server.Login(context.Background(), &pb.LoginRequest{OnlineId: "virtual", Password: "virtual"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// 旧的明文密码在第一次登录成功后改为哈希
+	db.Create(&models.Customer{OnlineID: "legacy", Password: "plain", Name: "Legacy", Address: "Address 2"})
+	_, err = server.Login(context.Background(), &pb.LoginRequest{OnlineId: "legacy", Password: "wrong"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	resp, err = server.Login(context.Background(), &pb.LoginRequest{OnlineId: "legacy", Password: "plain"})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	var legacy models.Customer
+	db.First(&legacy, "online_id = ?", "legacy")
+	assert.NotEqual(t, "plain", legacy.Password)
+	match, hashed := checkPassword(legacy.Password, "plain")
+	assert.True(t, match)
+	assert.True(t, hashed)
+}
+
+func TestTokenSigner(t *testing.T) {
+	signer := NewTokenSigner([]byte("test-secret"), time.Hour)
+	token, _, err := signer.Sign("customer1", RoleCustomer)
+	assert.NoError(t, err)
+
+	// 其他密钥签发的令牌无效
+	_, err = NewTokenSigner([]byte("other-secret"), time.Hour).Verify(token)
+	assert.Equal(t, errInvalidToken, err)
+
+	// 篡改过的令牌无效
+	_, err = signer.Verify("x" + token)
+	assert.Equal(t, errInvalidToken, err)
+
+	// 过期的令牌无效
+	expired := NewTokenSigner([]byte("test-secret"), -time.Minute)
+	token, _, err = expired.Sign("customer1", RoleCustomer)
+	assert.NoError(t, err)
+	_, err = signer.Verify(token)
+	assert.Equal(t, errTokenExpired, err)
+}
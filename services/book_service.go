@@ -2,24 +2,33 @@ package services
 
 import (
 	"context"
-	"fmt"
 	"github.com/GoldenStain/goDB/models"
 	"time"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BookServiceServer struct {
 	pb.UnimplementedBookServiceServer
 	db *gorm.DB
+
+	// ReservationTTL 是预留库存默认的保留时间，客户不指定保留时间时使用
+	ReservationTTL time.Duration
+	// MaxReservationTTL 是客户可以请求的最长保留时间
+	MaxReservationTTL time.Duration
 }
 
 // NewBookServiceServer 用于创建 BookServiceServer
 func NewBookServiceServer(db *gorm.DB) *BookServiceServer {
 	return &BookServiceServer{
-		db: db,
+		db:                db,
+		ReservationTTL:    defaultReservationTTL,
+		MaxReservationTTL: defaultMaxReservationTTL,
 	}
 }
 
@@ -27,59 +36,76 @@ func NewBookServiceServer(db *gorm.DB) *BookServiceServer {
 func (s *BookServiceServer) CreateBook(ctx context.Context, req *pb.CreateBookRequest) (*pb.CreateBookResponse, error) {
 	// 验证用户输入
 	if req.GetBookNo() == "" {
-		return &pb.CreateBookResponse{
-			Success:  false,
-			Feedback: "BookNo is required",
-		}, nil
+		return nil, invalidArgumentError("book_no", "BookNo is required")
 	}
 
 	if req.GetTitle() == "" {
-		return &pb.CreateBookResponse{
-			Success:  false,
-			Feedback: "Title is required",
-		}, nil
+		return nil, invalidArgumentError("title", "Title is required")
 	}
 
-	if req.GetPublisherName() == "" {
-		return &pb.CreateBookResponse{
-			Success:  false,
-			Feedback: "Publisher name is required or publisher_id must be provided",
-		}, nil
+	if req.GetPublisherName() == "" && req.GetPublisherId() == 0 {
+		return nil, invalidArgumentError("publisher_name", "Publisher name is required or publisher_id must be provided")
 	}
 
 	if req.GetPrice() <= 0 {
-		return &pb.CreateBookResponse{
-			Success:  false,
-			Feedback: "Price must be greater than 0",
-		}, nil
+		return nil, invalidArgumentError("price", "Price must be greater than 0")
 	}
 
 	if req.GetStockQuantity() <= 0 {
-		return &pb.CreateBookResponse{
-			Success:  false,
-			Feedback: "Stock quantity must be greater than 0",
-		}, nil
+		return nil, invalidArgumentError("stock_quantity", "Stock quantity must be greater than 0")
+	}
+
+	if req.GetReorderPoint() < 0 {
+		return nil, invalidArgumentError("reorder_point", "Reorder point cannot be negative")
+	}
+
+	if req.GetReorderQuantity() < 0 {
+		return nil, invalidArgumentError("reorder_quantity", "Reorder quantity cannot be negative")
+	}
+
+	// 查找出版社，只给出名称时自动创建
+	publisher, err := findPublisher(s.db, req.GetPublisherId(), req.GetPublisherName())
+	if err != nil {
+		return nil, err
 	}
 
 	// 构建新的Book对象
 	book := &models.Book{
-		BookNo:        req.GetBookNo(),
-		Title:         req.GetTitle(),
-		PublisherName: req.GetPublisherName(),
-		Price:         req.GetPrice(),
-		StockQuantity: req.GetStockQuantity(),
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
-		Authors:       req.GetAuthors(),
-		Keywords:      req.GetKeywords(),
+		BookNo:          req.GetBookNo(),
+		Title:           req.GetTitle(),
+		PublisherName:   publisher.Name,
+		PublisherID:     &publisher.ID,
+		Price:           req.GetPrice(),
+		StockQuantity:   req.GetStockQuantity(),
+		ReorderPoint:    req.GetReorderPoint(),
+		ReorderQuantity: req.GetReorderQuantity(),
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		Authors:         req.GetAuthors(),
+		Keywords:        req.GetKeywords(),
+	}
+
+	// 关联丛书
+	if req.GetSeriesId() != 0 {
+		if err := s.db.First(&models.Series{}, req.GetSeriesId()).Error; err != nil {
+			return nil, status.Error(codes.NotFound, "Series not found")
+		}
+		seriesID := req.GetSeriesId()
+		book.SeriesID = &seriesID
 	}
 
-	// 写入数据库
-	if err := s.db.Create(&book).Error; err != nil {
-		return &pb.CreateBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to create book: %v", err),
-		}, nil
+	// 写入数据库，初始库存记为调整
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&book).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return status.Error(codes.AlreadyExists, "Book already exists")
+			}
+			return status.Errorf(codes.Internal, "failed to create book: %v", err)
+		}
+		return recordStockMovement(tx, book.BookNo, book.StockQuantity, models.StockMovementAdjustment, 0, callerActor(ctx))
+	})
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
 	// 返回成功的响应
@@ -91,97 +117,111 @@ func (s *BookServiceServer) CreateBook(ctx context.Context, req *pb.CreateBookRe
 
 // GetBook 根据 ID 获取书籍
 func (s *BookServiceServer) GetBook(ctx context.Context, req *pb.GetBookRequest) (*pb.GetBookResponse, error) {
-	// 验证请求参数
-	if req.GetStart() < 0 || req.GetStop() < req.GetStart() {
-		return &pb.GetBookResponse{
-			Success:  false,
-			Feedback: "Invalid range: start must be >= 0 and stop must be >= start",
-		}, nil
-	}
-
 	// 查询书籍
-	var books []*models.Book
-	if err := s.db.Offset(int(req.GetStart())).Limit(int(req.GetStop() - req.GetStart() + 1)).Find(&books).Error; err != nil {
-		return &pb.GetBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query books: %v", err),
-		}, nil
+	result, err := paginate(s.db.Preload("Series"), req, pageScope("books"), func(book *models.Book) (time.Time, int32) {
+		return book.CreatedAt, book.ID
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// 构建响应
 	var pbBooks []*pb.Book
-	for _, book := range books {
-		pbBook := &pb.Book{
-			Id:            book.ID,
-			BookNo:        book.BookNo,
-			Title:         book.Title,
-			Price:         book.Price,
-			StockQuantity: book.StockQuantity,
-			PublisherName: book.PublisherName,
-			Keywords:      book.Keywords,
-			Authors:       book.Authors,
-			CreatedAt:     timestamppb.New(book.CreatedAt),
-			UpdatedAt:     timestamppb.New(book.UpdatedAt),
-		}
-
-		pbBooks = append(pbBooks, pbBook)
+	for _, book := range result.Items {
+		pbBooks = append(pbBooks, toPbBook(book))
 	}
 
 	// 返回响应
 	return &pb.GetBookResponse{
-		Success:  true,
-		Feedback: "Books retrieved successfully",
-		Books:    pbBooks,
+		Success:       true,
+		Feedback:      "Books retrieved successfully",
+		Books:         pbBooks,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    result.TotalCount,
 	}, nil
 }
 
-// UpdateBook 更新现有书籍
+// StreamBooks 导出全部书籍，每条记录发送一个消息
+func (s *BookServiceServer) StreamBooks(req *pb.StreamBooksRequest, stream pb.BookService_StreamBooksServer) error {
+	return streamRecords(stream.Context(), s.db.Preload("Series"), func(book *models.Book) error {
+		return stream.Send(toPbBook(book))
+	})
+}
+
+// UpdateBook 更新现有书籍，修改在库数量时把差额记为调整
 func (s *BookServiceServer) UpdateBook(ctx context.Context, req *pb.UpdateBookRequest) (*pb.UpdateBookResponse, error) {
-	// 查找书籍
-	var book models.Book
-	if err := s.db.Where("book_no = ?", req.GetBookNo()).First(&book).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return &pb.UpdateBookResponse{
-				Success:  false,
-				Feedback: "Book not found",
-			}, nil
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 查找书籍并加行锁，差额基于最新的在库数量计算
+		var book models.Book
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("book_no = ?", req.GetBookNo()).First(&book).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Error(codes.NotFound, "Book not found")
+			}
+			return status.Errorf(codes.Internal, "failed to query book: %v", err)
 		}
-		return &pb.UpdateBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query book: %v", err),
-		}, nil
-	}
 
-	// 更新字段
-	if req.GetBookNo() != "" {
-		book.BookNo = req.GetBookNo()
-	}
-	if req.GetTitle() != "" {
-		book.Title = req.GetTitle()
-	}
-	if req.GetPublisherName() != "" {
-		book.PublisherName = req.GetPublisherName()
-	}
-	if req.GetPrice() > 0 {
-		book.Price = int32(req.GetPrice())
-	}
-	if req.GetStockQuantity() > 0 {
-		book.StockQuantity = req.GetStockQuantity()
-	}
-	if req.GetAuthors() != "" {
-		book.Authors = req.GetAuthors()
-	}
-	if req.GetKeywords() != "" {
-		book.Keywords = req.GetKeywords()
-	}
-	book.UpdatedAt = time.Now()
+		// 更新字段
+		if req.GetBookNo() != "" {
+			book.BookNo = req.GetBookNo()
+		}
+		if req.GetTitle() != "" {
+			book.Title = req.GetTitle()
+		}
+		if req.GetPublisherId() != 0 || req.GetPublisherName() != "" {
+			publisher, err := findPublisher(tx, req.GetPublisherId(), req.GetPublisherName())
+			if err != nil {
+				return err
+			}
+			book.PublisherID = &publisher.ID
+			book.PublisherName = publisher.Name
+		}
+		if req.GetSeriesId() != 0 {
+			if err := tx.First(&models.Series{}, req.GetSeriesId()).Error; err != nil {
+				return status.Error(codes.NotFound, "Series not found")
+			}
+			seriesID := req.GetSeriesId()
+			book.SeriesID = &seriesID
+		}
+		if req.GetPrice() > 0 {
+			book.Price = int32(req.GetPrice())
+		}
+		var delta int32
+		if req.GetStockQuantity() > 0 {
+			// 在库数量不能少于客户预留的数量
+			if req.GetStockQuantity() < book.ReservedQuantity {
+				return status.Errorf(codes.FailedPrecondition, "Stock quantity cannot be less than the reserved quantity %d", book.ReservedQuantity)
+			}
+			delta = req.GetStockQuantity() - book.StockQuantity
+			book.StockQuantity = req.GetStockQuantity()
+		}
+		if req.GetAuthors() != "" {
+			book.Authors = req.GetAuthors()
+		}
+		if req.GetKeywords() != "" {
+			book.Keywords = req.GetKeywords()
+		}
+		if req.ReorderPoint != nil {
+			if req.GetReorderPoint() < 0 {
+				return invalidArgumentError("reorder_point", "Reorder point cannot be negative")
+			}
+			book.ReorderPoint = req.GetReorderPoint()
+		}
+		if req.ReorderQuantity != nil {
+			if req.GetReorderQuantity() < 0 {
+				return invalidArgumentError("reorder_quantity", "Reorder quantity cannot be negative")
+			}
+			book.ReorderQuantity = req.GetReorderQuantity()
+		}
+		book.UpdatedAt = time.Now()
 
-	// 保存更新
-	if err := s.db.Save(&book).Error; err != nil {
-		return &pb.UpdateBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to update book: %v", err),
-		}, nil
+		// 保存更新
+		if err := tx.Save(&book).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to update book: %v", err)
+		}
+		return recordStockMovement(tx, book.BookNo, delta, models.StockMovementAdjustment, 0, callerActor(ctx))
+	})
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
 	// 返回成功的响应
@@ -197,23 +237,20 @@ func (s *BookServiceServer) DeleteBook(ctx context.Context, req *pb.DeleteBookRe
 	var book models.Book
 	if err := s.db.First(&book, req.GetBookId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.DeleteBookResponse{
-				Success:  false,
-				Feedback: "Book not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Book not found")
 		}
-		return &pb.DeleteBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query book: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query book: %v", err)
 	}
 
-	// 删除书籍
-	if err := s.db.Delete(&book).Error; err != nil {
-		return &pb.DeleteBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to delete book: %v", err),
-		}, nil
+	// 删除书籍，剩余的库存记为调整，变动之和回到 0
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&book).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to delete book: %v", err)
+		}
+		return recordStockMovement(tx, book.BookNo, -book.StockQuantity, models.StockMovementAdjustment, 0, callerActor(ctx))
+	})
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
 	// 返回成功的响应
@@ -222,3 +259,33 @@ func (s *BookServiceServer) DeleteBook(ctx context.Context, req *pb.DeleteBookRe
 		Feedback: "Book deleted successfully",
 	}, nil
 }
+
+// toPbBook 把书籍模型转换为 protobuf 消息
+func toPbBook(book *models.Book) *pb.Book {
+	pbBook := &pb.Book{
+		Id:                book.ID,
+		BookNo:            book.BookNo,
+		Title:             book.Title,
+		Price:             book.Price,
+		StockQuantity:     book.StockQuantity,
+		ReservedQuantity:  book.ReservedQuantity,
+		AvailableQuantity: book.AvailableQuantity(),
+		ReorderPoint:      book.ReorderPoint,
+		ReorderQuantity:   book.ReorderQuantity,
+		PublisherName:     book.PublisherName,
+		Keywords:          book.Keywords,
+		Authors:           book.Authors,
+		CreatedAt:         timestamppb.New(book.CreatedAt),
+		UpdatedAt:         timestamppb.New(book.UpdatedAt),
+	}
+	if book.PublisherID != nil {
+		pbBook.PublisherId = *book.PublisherID
+	}
+	if book.SeriesID != nil {
+		pbBook.SeriesId = *book.SeriesID
+	}
+	if book.Series != nil {
+		pbBook.SeriesName = book.Series.Name
+	}
+	return pbBook
+}
@@ -6,20 +6,11 @@ import (
 	"testing"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
-	"github.com/GoldenStain/goDB/models"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
 )
 
-func setupTestDBBookService() *gorm.DB {
-	db, _ := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	models.AutoMigrate(db)
-	return db
-}
-
 func TestCreateAndGetBook(t *testing.T) {
-	db := setupTestDBBookService()
+	db := setupTestDB(t)
 	server := NewBookServiceServer(db)
 
 	// 添加 20 本书
@@ -3,17 +3,29 @@ package services
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // 定义折扣数组和透支额度数组
 var discount = []int{0, 10, 15, 15, 20, 25}
 var overdraft = []int{0, 0, 0, 250, 500, 65536}
 
+var (
+	errCustomerNotFound      = status.Error(codes.NotFound, "Customer not found")
+	errCustomerOrderNotFound = status.Error(codes.NotFound, "Customer order not found")
+	errInsufficientStock     = status.Error(codes.FailedPrecondition, "Insufficient stock")
+	errInsufficientBalance   = status.Error(codes.FailedPrecondition, "Insufficient account balance and overdraft limit")
+)
+
 type CustomerOrderServiceServer struct {
 	pb.UnimplementedCustomerOrderServiceServer
 	db *gorm.DB
@@ -26,214 +38,353 @@ func NewCustomerOrderServiceServer(db *gorm.DB) *CustomerOrderServiceServer {
 	}
 }
 
-// CreateCustomerOrder 创建客户订单
+// CreateCustomerOrder 创建客户订单，一个订单可以包含多行明细，整单统一计算折扣和扣款
 func (s *CustomerOrderServiceServer) CreateCustomerOrder(ctx context.Context, req *pb.CreateCustomerOrderRequest) (*pb.CreateCustomerOrderResponse, error) {
 	// 验证用户输入
 	if req.GetOrderDate() == "" {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Order date is required",
-		}, nil
+		return nil, invalidArgumentError("order_date", "Order date is required")
 	}
 
 	if req.GetCustomerOnlineId() == "" {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Customer ID or CustomerOnlineId is required",
-		}, nil
+		return nil, invalidArgumentError("customer_online_id", "Customer ID or CustomerOnlineId is required")
 	}
 
-	if req.GetBookNo() == "" {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Book No is required",
-		}, nil
+	// 客户只能为自己下单
+	if onlineID, ok := customerScope(ctx); ok && req.GetCustomerOnlineId() != onlineID {
+		return nil, status.Error(codes.PermissionDenied, "Customers can only create their own orders")
 	}
 
-	if req.GetBookCount() <= 0 {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Book count must be greater than 0",
-		}, nil
+	// 兼容旧的单书请求，没有明细时使用 book_no、book_count 和 price 作为唯一一行
+	items := req.GetItems()
+	if len(items) == 0 {
+		items = []*pb.CustomerOrderItem{{
+			BookNo:    req.GetBookNo(),
+			BookCount: req.GetBookCount(),
+			Price:     req.GetPrice(),
+		}}
 	}
 
-	if req.GetPrice() <= 0 {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Price must be greater than 0",
-		}, nil
+	// 验证每一行明细，并按书号汇总数量
+	bookCounts := make(map[string]int32)
+	bookPrices := make(map[string]int32)
+	for i, item := range items {
+		// 出错字段指向请求中实际填写的位置
+		fieldPrefix := ""
+		if len(req.GetItems()) > 0 {
+			fieldPrefix = fmt.Sprintf("items[%d].", i)
+		}
+
+		if item.GetBookNo() == "" {
+			return nil, invalidArgumentError(fieldPrefix+"book_no", "Book No is required")
+		}
+
+		if item.GetBookCount() <= 0 {
+			return nil, invalidArgumentError(fieldPrefix+"book_count", "Book count must be greater than 0")
+		}
+
+		if item.GetPrice() <= 0 {
+			return nil, invalidArgumentError(fieldPrefix+"price", "Price must be greater than 0")
+		}
+
+		bookCounts[item.GetBookNo()] += item.GetBookCount()
+		bookPrices[item.GetBookNo()] += item.GetPrice()
 	}
 
 	if req.GetAddress() == "" {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Address is required",
-		}, nil
-	}
-
-	if req.GetStatus() == "" {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Status is required",
-		}, nil
-	}
-
-	// 查找客户
-	var customer models.Customer
-	if err := s.db.Where("online_id = ?", req.GetCustomerOnlineId()).First(&customer).Error; err != nil {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Customer not found",
-		}, nil
-	}
-
-	// 查找书籍信息
-	var book models.Book
-	if err := s.db.Where("book_no = ?", req.GetBookNo()).First(&book).Error; err != nil {
-		// 如果书籍不存在，创建新书记录
-		book = models.Book{
-			BookNo:        req.GetBookNo(),
-			Title:         "Unknown Title",
-			PublisherName: "Unknown Publisher",
-			Authors:       "Unknown Author",
-			StockQuantity: 0,
-			CreatedAt:     time.Now(),
-			UpdatedAt:     time.Now(),
-		}
-		if err := s.db.Create(&book).Error; err != nil {
-			return &pb.CreateCustomerOrderResponse{
-				Success:  false,
-				Feedback: fmt.Sprintf("Failed to create book: %v", err),
-			}, nil
-		}
-	}
-
-	// 检查库存是否足够
-	if book.StockQuantity < req.GetBookCount() {
-		// 如果库存不足，创建缺书记录
-		stockRequest := &models.StockRequest{
-			BookNo:      req.GetBookNo(),
-			Title:       book.Title,
-			Publisher:   book.PublisherName,
-			Supplier:    "Unknown Supplier",
-			Author:      book.Authors,
-			Quantity:    req.GetBookCount() - book.StockQuantity,
-			RequestDate: time.Now().Format("2006-01-02"),
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
-		}
-		if err := s.db.Create(&stockRequest).Error; err != nil {
-			return &pb.CreateCustomerOrderResponse{
-				Success:  false,
-				Feedback: fmt.Sprintf("Failed to create stock request: %v", err),
-			}, nil
-		}
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Insufficient stock, stock request created",
-		}, nil
-	}
-
-	// 计算折扣后的价格
-	discount := discount[customer.CreditLevel]
-	finalPrice := req.GetPrice() * (100 - int32(discount)) / 100
+		return nil, invalidArgumentError("address", "Address is required")
+	}
 
-	// 检查客户余额和透支额度是否足够
-	if customer.AccountBalance+int32(overdraft[customer.CreditLevel]) < finalPrice {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Insufficient account balance and overdraft limit",
-		}, nil
-	}
-
-	// 扣除客户余额
-	customer.AccountBalance -= finalPrice
-	if err := s.db.Save(&customer).Error; err != nil {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to update customer balance: %v", err),
-		}, nil
-	}
-
-	// 更新库存
-	book.StockQuantity -= req.GetBookCount()
-	if err := s.db.Save(&book).Error; err != nil {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to update stock: %v", err),
-		}, nil
-	}
-
-	// 构建新的 CustomerOrder 对象
-	customerOrder := &models.CustomerOrder{
-		OrderDate:        req.GetOrderDate(),
-		CustomerOnlineID: req.GetCustomerOnlineId(),
-		BookNo:           req.GetBookNo(),
-		BookCount:        req.GetBookCount(),
-		Price:            req.GetPrice(),
-		Address:          req.GetAddress(),
-		Status:           req.GetStatus(),
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
-	}
-
-	// 写入数据库
-	if err := s.db.Create(&customerOrder).Error; err != nil {
-		return &pb.CreateCustomerOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to create customer order: %v", err),
-		}, nil
-	}
-
-	// // 手动将订单添加到客户的 CustomerOrders 列表中
-	// if err := s.db.Model(&customer).Association("CustomerOrders").Append(&customerOrder); err != nil {
-	// 	return &pb.CreateCustomerOrderResponse{
-	// 		Success:  false,
-	// 		Feedback: fmt.Sprintf("Failed to associate customer order: %v", err),
-	// 	}, nil
-	// }
+	// 到货后自动下单只适用于只有一本书、不允许缺货的订单
+	if req.GetAutoFulfill() {
+		if len(bookCounts) != 1 {
+			return nil, invalidArgumentError("auto_fulfill", "Auto fulfilment is only available for orders of a single book")
+		}
+		if req.GetAllowBackorder() {
+			return nil, invalidArgumentError("auto_fulfill", "Auto fulfilment cannot be combined with allow_backorder")
+		}
+	}
+
+	// 新订单在创建时已经扣款，状态只能是已付款
+	orderStatus, err := requestedOrderStatus(req.GetOrderStatus(), req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	if orderStatus != "" && orderStatus != models.OrderStatusPaid {
+		return nil, invalidArgumentError("order_status", fmt.Sprintf("New orders must start as %s", models.OrderStatusPaid))
+	}
+
+	// 按书号排序加锁，避免多个订单互相等待造成死锁
+	bookNos := make([]string, 0, len(bookCounts))
+	for bookNo := range bookCounts {
+		bookNos = append(bookNos, bookNo)
+	}
+	sort.Strings(bookNos)
+
+	// 在同一个事务中完成扣款、扣库存和写订单，任何一步失败都整体回滚
+	insufficientStock := false
+	var customerOrder *models.CustomerOrder
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 查找客户并加行锁
+		var customer models.Customer
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("online_id = ?", req.GetCustomerOnlineId()).First(&customer).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errCustomerNotFound
+			}
+			return status.Errorf(codes.Internal, "Failed to query customer: %v", err)
+		}
+
+		// 查找书籍信息并加行锁
+		books := make(map[string]*models.Book, len(bookNos))
+		for _, bookNo := range bookNos {
+			var book models.Book
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("book_no = ?", bookNo).First(&book).Error; err != nil {
+				if err != gorm.ErrRecordNotFound {
+					return status.Errorf(codes.Internal, "Failed to query book: %v", err)
+				}
+				// 如果书籍不存在，创建新书记录
+				book = models.Book{
+					BookNo:        bookNo,
+					Title:         "Unknown Title",
+					PublisherName: "Unknown Publisher",
+					Authors:       "Unknown Author",
+					StockQuantity: 0,
+					CreatedAt:     time.Now(),
+					UpdatedAt:     time.Now(),
+				}
+				if err := tx.Create(&book).Error; err != nil {
+					return status.Errorf(codes.Internal, "Failed to create book: %v", err)
+				}
+			}
+			books[bookNo] = &book
+		}
+
+		// 检查没有被预留的库存是否足够。允许缺货时记录缺货的数量，订单创建后再登记；
+		// 否则为每本缺货的书登记等待到货的客户并创建缺书记录，缺书记录和等待登记需要随事务提交
+		backordered := make(map[string]int32)
+		for _, bookNo := range bookNos {
+			book := books[bookNo]
+			if book.AvailableQuantity() >= bookCounts[bookNo] {
+				continue
+			}
+			if req.GetAllowBackorder() {
+				backordered[bookNo] = bookCounts[bookNo] - book.AvailableQuantity()
+				continue
+			}
+			waitlist := &models.StockWaitlist{
+				CustomerOnlineID: customer.OnlineID,
+				BookNo:           bookNo,
+				Quantity:         bookCounts[bookNo],
+				Price:            bookPrices[bookNo],
+				Address:          req.GetAddress(),
+				AutoFulfill:      req.GetAutoFulfill(),
+				Status:           models.WaitlistWaiting,
+			}
+			if err := addToWaitlist(tx, book, waitlist, bookCounts[bookNo]-book.AvailableQuantity()); err != nil {
+				return err
+			}
+			insufficientStock = true
+		}
+		if insufficientStock {
+			return nil
+		}
+
+		// 构建新的 CustomerOrder 对象，缺货的数量依次分配到同一本书的各行，扣款、扣库存后写入
+		customerOrder = &models.CustomerOrder{
+			OrderDate:        req.GetOrderDate(),
+			CustomerOnlineID: req.GetCustomerOnlineId(),
+			Address:          req.GetAddress(),
+		}
+		unassigned := make(map[string]int32, len(backordered))
+		for bookNo, count := range backordered {
+			unassigned[bookNo] = count
+		}
+		for _, item := range items {
+			backorderedCount := min(item.GetBookCount(), unassigned[item.GetBookNo()])
+			unassigned[item.GetBookNo()] -= backorderedCount
+			customerOrder.Items = append(customerOrder.Items, &models.CustomerOrderItem{
+				BookNo:           item.GetBookNo(),
+				BookCount:        item.GetBookCount(),
+				Price:            item.GetPrice(),
+				BackorderedCount: backorderedCount,
+			})
+		}
+		if err := placePaidOrder(tx, &customer, customerOrder, callerActor(ctx)); err != nil {
+			return err
+		}
+
+		// 为缺货的书创建对应这个订单的缺书登记，订单与其他客户一起按先后顺序等待到货
+		for _, bookNo := range bookNos {
+			if backordered[bookNo] == 0 {
+				continue
+			}
+			stockRequest, err := createStockRequest(tx, books[bookNo], backordered[bookNo], &customerOrder.ID)
+			if err != nil {
+				return err
+			}
+			waitlist := &models.StockWaitlist{
+				CustomerOnlineID: customer.OnlineID,
+				BookNo:           bookNo,
+				Quantity:         backordered[bookNo],
+				Price:            bookPrices[bookNo],
+				Address:          req.GetAddress(),
+				StockRequestID:   stockRequest.ID,
+				Status:           models.WaitlistWaiting,
+				CustomerOrderID:  &customerOrder.ID,
+			}
+			if err := tx.Create(waitlist).Error; err != nil {
+				return status.Errorf(codes.Internal, "Failed to create stock waitlist: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	if insufficientStock {
+		return nil, status.Error(codes.FailedPrecondition, "Insufficient stock, stock request created")
+	}
+
+	feedback := "Customer order created successfully"
+	if customerOrder.Status == models.OrderStatusBackordered {
+		feedback = "Customer order created successfully, some books are backordered"
+	}
 
 	// 返回成功的响应
 	return &pb.CreateCustomerOrderResponse{
 		Success:  true,
-		Feedback: "Customer order created successfully",
+		Feedback: feedback,
+		Id:       customerOrder.ID,
 	}, nil
 }
 
-// GetCustomerOrder 获取客户订单
+// createStockRequest 为缺货的书创建缺书登记，orderID 是缺货订单，不是缺货订单时为空
+func createStockRequest(tx *gorm.DB, book *models.Book, quantity int32, orderID *int32) (*models.StockRequest, error) {
+	stockRequest := &models.StockRequest{
+		BookNo:          book.BookNo,
+		Title:           book.Title,
+		Publisher:       book.PublisherName,
+		Supplier:        "Unknown Supplier",
+		Author:          book.Authors,
+		Quantity:        quantity,
+		RequestDate:     time.Now().Format("2006-01-02"),
+		CustomerOrderID: orderID,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	if err := tx.Create(stockRequest).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create stock request: %v", err)
+	}
+	return stockRequest, nil
+}
+
+// placePaidOrder 在事务 tx 中按客户的信用等级计算整单折扣，扣除客户余额和明细中书籍的库存，写入已付款的订单。
+// 明细中缺货的数量不扣除库存，有缺货时订单状态为 backordered。
+// 余额和库存都使用带条件的更新，不足时返回 errInsufficientBalance 或 errInsufficientStock，调用者需要回滚事务。
+// 扣除的库存以 actor 的名义记为销售。
+func placePaidOrder(tx *gorm.DB, customer *models.Customer, order *models.CustomerOrder, actor string) error {
+	// 按书号汇总有货的数量，按书号排序更新库存，避免多个事务互相等待造成死锁
+	var totalPrice int32
+	bookCounts := make(map[string]int32)
+	orderStatus := models.OrderStatusPaid
+	for _, item := range order.Items {
+		totalPrice += item.Price
+		if item.BookCount > item.BackorderedCount {
+			bookCounts[item.BookNo] += item.BookCount - item.BackorderedCount
+		}
+		if item.BackorderedCount > 0 {
+			orderStatus = models.OrderStatusBackordered
+		}
+	}
+	bookNos := make([]string, 0, len(bookCounts))
+	for bookNo := range bookCounts {
+		bookNos = append(bookNos, bookNo)
+	}
+	sort.Strings(bookNos)
+
+	// 计算整单折扣后的价格
+	discount := discount[customer.CreditLevel]
+	finalPrice := totalPrice * (100 - int32(discount)) / 100
+	limit := int32(overdraft[customer.CreditLevel])
+
+	// 检查客户余额和透支额度是否足够
+	if customer.AccountBalance+limit < finalPrice {
+		return errInsufficientBalance
+	}
+
+	// 扣除客户余额，带条件更新，防止不支持行锁的数据库出现超额扣款
+	result := tx.Model(&models.Customer{}).
+		Where("id = ? AND account_balance + ? >= ?", customer.ID, limit, finalPrice).
+		Update("account_balance", gorm.Expr("account_balance - ?", finalPrice))
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "Failed to update customer balance: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errInsufficientBalance
+	}
+
+	// 更新库存，带条件更新，保证不会用到其他客户预留的库存
+	for _, bookNo := range bookNos {
+		result = tx.Model(&models.Book{}).
+			Where("book_no = ? AND stock_quantity - reserved_quantity >= ?", bookNo, bookCounts[bookNo]).
+			Update("stock_quantity", gorm.Expr("stock_quantity - ?", bookCounts[bookNo]))
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "Failed to update stock: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errInsufficientStock
+		}
+	}
+
+	// 写入数据库，明细和状态记录随订单一起创建
+	now := time.Now()
+	order.Price = totalPrice
+	order.PaidAmount = finalPrice
+	order.Status = orderStatus
+	order.CreatedAt = now
+	order.UpdatedAt = now
+	order.StatusHistory = []*models.CustomerOrderStatusTransition{{
+		ToStatus:  orderStatus,
+		CreatedAt: now,
+	}}
+	for _, item := range order.Items {
+		item.CreatedAt = now
+		item.UpdatedAt = now
+	}
+	if err := tx.Create(order).Error; err != nil {
+		return status.Errorf(codes.Internal, "Failed to create customer order: %v", err)
+	}
+
+	// 订单有了ID之后记录库存变动
+	for _, bookNo := range bookNos {
+		if err := recordStockMovement(tx, bookNo, -bookCounts[bookNo], models.StockMovementSale, order.ID, actor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCustomerOrder 获取客户订单，支持游标分页和按状态、客户、书号、创建时间过滤
 func (s *CustomerOrderServiceServer) GetCustomerOrder(ctx context.Context, req *pb.GetCustomerOrderRequest) (*pb.GetCustomerOrderResponse, error) {
-	// 验证请求参数
-	if req.GetStart() < 0 || req.GetStop() < req.GetStart() {
-		return &pb.GetCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Invalid range: start must be >= 0 and stop must be >= start",
-		}, nil
+	// 构建查询，客户只能查询自己的订单
+	query, err := s.customerOrderQuery(ctx, req.GetOrderStatus(), req.GetCustomerOnlineId(), req.GetBookNo(), req.GetCreatedAfter(), req.GetCreatedBefore())
+	if err != nil {
+		return nil, err
 	}
 
-	// 查询客户订单
-	var customerOrders []*models.CustomerOrder
-	if err := s.db.Offset(int(req.GetStart())).Limit(int(req.GetStop() - req.GetStart() + 1)).Find(&customerOrders).Error; err != nil {
-		return &pb.GetCustomerOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query customer orders: %v", err),
-		}, nil
+	// 查询客户订单及其明细和状态记录
+	scope := pageScope("customer_orders", req.GetOrderStatus(), req.GetCustomerOnlineId(), req.GetBookNo(), req.GetCreatedAfter(), req.GetCreatedBefore())
+	result, err := paginate(query.Preload("Items").Preload("StatusHistory", orderByID), req, scope, func(order *models.CustomerOrder) (time.Time, int32) {
+		return order.CreatedAt, order.ID
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// 构建响应
 	var pbCustomerOrders []*pb.CustomerOrder
-	for _, customerOrder := range customerOrders {
-		pbCustomerOrder := &pb.CustomerOrder{
-			Id:               customerOrder.ID,
-			OrderDate:        customerOrder.OrderDate,
-			CustomerOnlineId: customerOrder.CustomerOnlineID,
-			BookNo:           customerOrder.BookNo,
-			BookCount:        customerOrder.BookCount,
-			Price:            customerOrder.Price,
-			Address:          customerOrder.Address,
-			Status:           customerOrder.Status,
-		}
-
-		pbCustomerOrders = append(pbCustomerOrders, pbCustomerOrder)
+	for _, customerOrder := range result.Items {
+		pbCustomerOrders = append(pbCustomerOrders, toPbCustomerOrder(customerOrder))
 	}
 
 	// 返回响应
@@ -241,60 +392,101 @@ func (s *CustomerOrderServiceServer) GetCustomerOrder(ctx context.Context, req *
 		Success:        true,
 		Feedback:       "Customer orders retrieved successfully",
 		CustomerOrders: pbCustomerOrders,
+		NextPageToken:  result.NextPageToken,
+		TotalCount:     result.TotalCount,
 	}, nil
 }
 
-// UpdateCustomerOrder 更新客户订单
+// UpdateCustomerOrder 更新客户订单。书号、数量和价格只能在付款之前修改，
+// 已付款的订单已经扣除了库存和余额，需要取消后重新下单
 func (s *CustomerOrderServiceServer) UpdateCustomerOrder(ctx context.Context, req *pb.UpdateCustomerOrderRequest) (*pb.UpdateCustomerOrderResponse, error) {
-	var customerOrder models.CustomerOrder
-
-	// 查询客户订单
-	if err := s.db.First(&customerOrder, req.GetId()).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return &pb.UpdateCustomerOrderResponse{
-				Success:  false,
-				Feedback: "Customer order not found",
-			}, nil
-		}
-		return &pb.UpdateCustomerOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query customer order: %v", err),
-		}, nil
-	}
-
-	// 更新字段
-	if req.GetOrderDate() != "" {
-		customerOrder.OrderDate = req.GetOrderDate()
-	}
 	if req.GetCustomerOnlineId() != "" {
 		// 这个字段不能更改
-		return &pb.UpdateCustomerOrderResponse{
-			Success:  false,
-			Feedback: "Customer ID or CustomerOnlineId cannot be changed",
-		}, nil
-	}
-	if req.GetBookNo() != "" {
-		customerOrder.BookNo = req.GetBookNo()
-	}
-	if req.GetBookCount() != 0 {
-		customerOrder.BookCount = req.GetBookCount()
-	}
-	if req.GetPrice() != 0 {
-		customerOrder.Price = req.GetPrice()
+		return nil, invalidArgumentError("customer_online_id", "Customer ID or CustomerOnlineId cannot be changed")
 	}
-	if req.GetAddress() != "" {
-		customerOrder.Address = req.GetAddress()
-	}
-	if req.GetStatus() != "" {
-		customerOrder.Status = req.GetStatus()
+	// 状态只能按允许的流程变更
+	orderStatus, err := requestedOrderStatus(req.GetOrderStatus(), req.GetStatus())
+	if err != nil {
+		return nil, err
 	}
+	changesItem := req.GetBookNo() != "" || req.GetBookCount() != 0 || req.GetPrice() != 0
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 查询客户订单及其明细并加行锁
+		var customerOrder models.CustomerOrder
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items").First(&customerOrder, req.GetId()).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errCustomerOrderNotFound
+			}
+			return status.Errorf(codes.Internal, "failed to query customer order: %v", err)
+		}
+
+		// 客户只能修改自己订单的收货地址，状态变更和取消需要走各自的流程
+		if err := checkOrderOwner(ctx, &customerOrder); err != nil {
+			return err
+		}
+		if _, ok := customerScope(ctx); ok {
+			if req.GetOrderDate() != "" || changesItem || req.GetStatus() != "" || req.GetOrderStatus() != pb.OrderStatus_ORDER_STATUS_UNSPECIFIED {
+				return status.Error(codes.PermissionDenied, "Customers can only change the address of their orders")
+			}
+		}
 
-	// 保存更新
-	if err := s.db.Save(&customerOrder).Error; err != nil {
-		return &pb.UpdateCustomerOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to update customer order: %v", err),
-		}, nil
+		// 只更新请求中修改的列
+		currentStatus, _ := models.ParseOrderStatus(string(customerOrder.Status))
+		updates := map[string]interface{}{}
+		if req.GetOrderDate() != "" {
+			updates["order_date"] = req.GetOrderDate()
+		}
+		if req.GetAddress() != "" {
+			updates["address"] = req.GetAddress()
+		}
+		if changesItem {
+			// 只有未付款的单行订单可以直接修改书号、数量和价格
+			if currentStatus != models.OrderStatusPending {
+				return status.Error(codes.FailedPrecondition, "Book No, book count and price cannot be changed after the order is paid")
+			}
+			if len(customerOrder.Items) != 1 {
+				return status.Error(codes.FailedPrecondition, "Book No, book count and price can only be changed on single-line orders")
+			}
+			itemUpdates := map[string]interface{}{"updated_at": time.Now()}
+			if req.GetBookNo() != "" {
+				itemUpdates["book_no"] = req.GetBookNo()
+			}
+			if req.GetBookCount() != 0 {
+				itemUpdates["book_count"] = req.GetBookCount()
+			}
+			if req.GetPrice() != 0 {
+				itemUpdates["price"] = req.GetPrice()
+				updates["price"] = req.GetPrice()
+			}
+			if err := tx.Model(customerOrder.Items[0]).Updates(itemUpdates).Error; err != nil {
+				return status.Errorf(codes.Internal, "failed to update customer order item: %v", err)
+			}
+		}
+		if len(updates) > 0 {
+			// 带状态条件更新，并发的取消或状态变更之后不会覆盖订单
+			updates["updated_at"] = time.Now()
+			result := tx.Model(&models.CustomerOrder{}).
+				Where("id = ? AND status = ?", customerOrder.ID, customerOrder.Status).
+				Updates(updates)
+			if result.Error != nil {
+				return status.Errorf(codes.Internal, "failed to update customer order: %v", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return status.Error(codes.FailedPrecondition, "Order status was changed by another request")
+			}
+		}
+
+		if currentStatus == models.OrderStatusBackordered && orderStatus == models.OrderStatusReady {
+			return status.Error(codes.FailedPrecondition, "Backordered orders become ready when the missing books arrive")
+		}
+		if orderStatus != "" && orderStatus != currentStatus {
+			return transitionOrderStatus(tx, &customerOrder, orderStatus)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
 	// 返回成功的响应
@@ -311,23 +503,23 @@ func (s *CustomerOrderServiceServer) DeleteCustomerOrder(ctx context.Context, re
 	// 查询客户订单
 	if err := s.db.First(&customerOrder, req.GetId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.DeleteCustomerOrderResponse{
-				Success:  false,
-				Feedback: "Customer order not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Customer order not found")
 		}
-		return &pb.DeleteCustomerOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query customer order: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query customer order: %v", err)
 	}
 
-	// 删除客户订单
-	if err := s.db.Delete(&customerOrder).Error; err != nil {
-		return &pb.DeleteCustomerOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to delete customer order: %v", err),
-		}, nil
+	// 删除客户订单及其明细和状态记录
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("order_id = ?", customerOrder.ID).Delete(&models.CustomerOrderItem{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("order_id = ?", customerOrder.ID).Delete(&models.CustomerOrderStatusTransition{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&customerOrder).Error
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete customer order: %v", err)
 	}
 
 	// 返回成功的响应
@@ -336,3 +528,159 @@ func (s *CustomerOrderServiceServer) DeleteCustomerOrder(ctx context.Context, re
 		Feedback: "Customer order deleted successfully",
 	}, nil
 }
+
+// CancelCustomerOrder 取消客户订单，在同一个事务中退还扣款并恢复库存，只允许取消尚未发货的订单
+func (s *CustomerOrderServiceServer) CancelCustomerOrder(ctx context.Context, req *pb.CancelCustomerOrderRequest) (*pb.CancelCustomerOrderResponse, error) {
+	var customerOrder models.CustomerOrder
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 查询客户订单及其明细并加行锁
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items").First(&customerOrder, req.GetId()).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errCustomerOrderNotFound
+			}
+			return status.Errorf(codes.Internal, "Failed to query customer order: %v", err)
+		}
+
+		// 客户只能取消自己的订单
+		if err := checkOrderOwner(ctx, &customerOrder); err != nil {
+			return err
+		}
+
+		// 变更状态，已发货的订单会在这里被拒绝
+		if err := transitionOrderStatus(tx, &customerOrder, models.OrderStatusCancelled); err != nil {
+			return err
+		}
+
+		// 退还客户实际支付的金额
+		result := tx.Model(&models.Customer{}).
+			Where("online_id = ?", customerOrder.CustomerOnlineID).
+			Update("account_balance", gorm.Expr("account_balance + ?", customerOrder.PaidAmount))
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "Failed to refund customer: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errCustomerNotFound
+		}
+
+		// 恢复已经扣除的库存并记为退货，书籍已被删除时无须恢复
+		for _, item := range customerOrder.Items {
+			if item.BookCount == item.BackorderedCount {
+				continue
+			}
+			result := tx.Model(&models.Book{}).
+				Where("book_no = ?", item.BookNo).
+				Update("stock_quantity", gorm.Expr("stock_quantity + ?", item.BookCount-item.BackorderedCount))
+			if result.Error != nil {
+				return status.Errorf(codes.Internal, "Failed to restore stock: %v", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			if err := recordStockMovement(tx, item.BookNo, item.BookCount-item.BackorderedCount, models.StockMovementReturn, customerOrder.ID, callerActor(ctx)); err != nil {
+				return err
+			}
+		}
+
+		// 缺货订单不再等待到货
+		if err := tx.Model(&models.StockWaitlist{}).
+			Where("customer_order_id = ? AND status = ?", customerOrder.ID, models.WaitlistWaiting).
+			Updates(map[string]interface{}{"status": models.WaitlistCancelled, "updated_at": time.Now()}).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to cancel stock waitlist: %v", err)
+		}
+
+		// 记录退款金额
+		customerOrder.RefundAmount = customerOrder.PaidAmount
+		if err := tx.Model(&customerOrder).Update("refund_amount", customerOrder.RefundAmount).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to record refund: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	// 返回成功的响应
+	return &pb.CancelCustomerOrderResponse{
+		Success:      true,
+		Feedback:     "Customer order cancelled successfully",
+		RefundAmount: customerOrder.RefundAmount,
+	}, nil
+}
+
+// StreamCustomerOrders 导出符合过滤条件的客户订单，每条记录发送一个消息，客户只能导出自己的订单
+func (s *CustomerOrderServiceServer) StreamCustomerOrders(req *pb.StreamCustomerOrdersRequest, stream pb.CustomerOrderService_StreamCustomerOrdersServer) error {
+	// 构建查询
+	ctx := stream.Context()
+	query, err := s.customerOrderQuery(ctx, req.GetOrderStatus(), req.GetCustomerOnlineId(), req.GetBookNo(), req.GetCreatedAfter(), req.GetCreatedBefore())
+	if err != nil {
+		return err
+	}
+
+	// 逐条发送订单及其明细和状态记录
+	return streamRecords(ctx, query.Preload("Items").Preload("StatusHistory", orderByID), func(customerOrder *models.CustomerOrder) error {
+		return stream.Send(toPbCustomerOrder(customerOrder))
+	})
+}
+
+// customerOrderQuery 按过滤条件构建客户订单查询，为空的条件不过滤，调用者是客户时只查询自己的订单
+func (s *CustomerOrderServiceServer) customerOrderQuery(ctx context.Context, pbStatus pb.OrderStatus, customerOnlineID, bookNo string, createdAfter, createdBefore *timestamppb.Timestamp) (*gorm.DB, error) {
+	// 验证过滤条件
+	orderStatus, err := requestedOrderStatus(pbStatus, "")
+	if err != nil {
+		return nil, err
+	}
+	created, err := createdBetween(createdAfter, createdBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.db.Scopes(created)
+	if onlineID, ok := customerScope(ctx); ok {
+		query = query.Where("customer_online_id = ?", onlineID)
+	}
+	if orderStatus != "" {
+		query = query.Where("status = ?", orderStatus)
+	}
+	if customerOnlineID != "" {
+		query = query.Where("customer_online_id = ?", customerOnlineID)
+	}
+	if bookNo != "" {
+		query = query.Where("id IN (?)", s.db.Model(&models.CustomerOrderItem{}).Select("order_id").Where("book_no = ?", bookNo))
+	}
+	return query, nil
+}
+
+// toPbCustomerOrder 把订单模型转换为 protobuf 消息，单行订单同时填充 book_no 和 book_count 以兼容旧客户端
+func toPbCustomerOrder(customerOrder *models.CustomerOrder) *pb.CustomerOrder {
+	pbCustomerOrder := &pb.CustomerOrder{
+		Id:               customerOrder.ID,
+		OrderDate:        customerOrder.OrderDate,
+		CustomerOnlineId: customerOrder.CustomerOnlineID,
+		Price:            customerOrder.Price,
+		Address:          customerOrder.Address,
+		Status:           string(customerOrder.Status),
+		PaidAmount:       customerOrder.PaidAmount,
+		RefundAmount:     customerOrder.RefundAmount,
+		OrderStatus:      toPbOrderStatus(customerOrder.Status),
+		StatusHistory:    toPbStatusHistory(customerOrder.StatusHistory),
+	}
+	for _, item := range customerOrder.Items {
+		pbCustomerOrder.Items = append(pbCustomerOrder.Items, &pb.CustomerOrderItem{
+			Id:               item.ID,
+			BookNo:           item.BookNo,
+			BookCount:        item.BookCount,
+			Price:            item.Price,
+			BackorderedCount: item.BackorderedCount,
+		})
+	}
+	if len(customerOrder.Items) == 1 {
+		pbCustomerOrder.BookNo = customerOrder.Items[0].BookNo
+		pbCustomerOrder.BookCount = customerOrder.Items[0].BookCount
+	}
+	return pbCustomerOrder
+}
+
+// orderByID 让预加载的记录按创建顺序排列
+func orderByID(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
@@ -2,28 +2,23 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/migrations"
 	"github.com/GoldenStain/goDB/models"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/driver/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
-func setupTestDBCustomerOrder(t *testing.T) *gorm.DB {
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("failed to open database: %v", err)
-	}
-	if err := db.AutoMigrate(&models.Book{}, &models.Customer{}, &models.CustomerOrder{}, &models.StockRequest{}); err != nil {
-		t.Fatalf("failed to migrate database: %v", err)
-	}
-	return db
-}
-
 func TestCreateCustomerOrder(t *testing.T) {
-	db := setupTestDBCustomerOrder(t)
+	db := setupTestDB(t)
 	server := NewCustomerOrderServiceServer(db)
 
 	// 添加客户
@@ -69,17 +64,20 @@ func TestCreateCustomerOrder(t *testing.T) {
 
 	// 验证客户余额更新
 	var updatedCustomer models.Customer
-	db.Preload("CustomerOrders").First(&updatedCustomer, "online_id = ?", "customer1")
+	db.Preload("CustomerOrders.Items").First(&updatedCustomer, "online_id = ?", "customer1")
 	assert.Equal(t, int32(910), updatedCustomer.AccountBalance)
 
 	// 验证客户订单关联
 	assert.Equal(t, 1, len(updatedCustomer.CustomerOrders))
-	assert.Equal(t, "B001", updatedCustomer.CustomerOrders[0].BookNo)
-	assert.Equal(t, int32(5), updatedCustomer.CustomerOrders[0].BookCount)
+	assert.Equal(t, int32(100), updatedCustomer.CustomerOrders[0].Price)
+	assert.Equal(t, int32(90), updatedCustomer.CustomerOrders[0].PaidAmount)
+	assert.Equal(t, 1, len(updatedCustomer.CustomerOrders[0].Items))
+	assert.Equal(t, "B001", updatedCustomer.CustomerOrders[0].Items[0].BookNo)
+	assert.Equal(t, int32(5), updatedCustomer.CustomerOrders[0].Items[0].BookCount)
 }
 
 func TestCreateCustomerOrderInsufficientStock(t *testing.T) {
-	db := setupTestDBCustomerOrder(t)
+	db := setupTestDB(t)
 	server := NewCustomerOrderServiceServer(db)
 
 	// 添加客户
@@ -112,31 +110,116 @@ func TestCreateCustomerOrderInsufficientStock(t *testing.T) {
 		Price:            100,
 		Address:          "Address 1",
 		Status:           "未发货",
+		AutoFulfill:      true,
 	}
-	resp, err := server.CreateCustomerOrder(context.Background(), req)
-	assert.NoError(t, err)
-	assert.False(t, resp.Success)
-	assert.Equal(t, "Insufficient stock, stock request created", resp.Feedback)
+	_, err := server.CreateCustomerOrder(context.Background(), req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "Insufficient stock, stock request created", status.Convert(err).Message())
 
 	// 验证缺书记录创建
 	var stockRequest models.StockRequest
 	db.First(&stockRequest, "book_no = ?", "B001")
 	assert.Equal(t, int32(3), stockRequest.Quantity)
+
+	// 验证客户登记为等待到货，需要的是订单中的全部数量
+	var waitlist models.StockWaitlist
+	db.First(&waitlist, "book_no = ?", "B001")
+	assert.Equal(t, "customer1", waitlist.CustomerOnlineID)
+	assert.Equal(t, int32(5), waitlist.Quantity)
+	assert.Equal(t, int32(100), waitlist.Price)
+	assert.Equal(t, stockRequest.ID, waitlist.StockRequestID)
+	assert.Equal(t, models.WaitlistWaiting, waitlist.Status)
+	assert.True(t, waitlist.AutoFulfill)
+}
+
+func TestCreateCustomerOrderMultipleItems(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewCustomerOrderServiceServer(db)
+
+	// 添加客户
+	customer := models.Customer{
+		OnlineID:       "customer1",
+		Password:       "password",
+		Name:           "Customer 1",
+		Address:        "Address 1",
+		AccountBalance: 1000,
+		CreditLevel:    2,
+	}
+	db.Create(&customer)
+
+	// 添加书籍
+	db.Create(&models.Book{BookNo: "B001", Title: "Book Title 1", StockQuantity: 10})
+	db.Create(&models.Book{BookNo: "B002", Title: "Book Title 2", StockQuantity: 1})
+
+	// 一个订单包含多本书，整单计算折扣并只扣款一次
+	req := &pb.CreateCustomerOrderRequest{
+		OrderDate:        "2023-01-01",
+		CustomerOnlineId: "customer1",
+		Address:          "Address 1",
+		Status:           "未发货",
+		Items: []*pb.CustomerOrderItem{
+			{BookNo: "B001", BookCount: 2, Price: 100},
+			{BookNo: "B002", BookCount: 1, Price: 60},
+			{BookNo: "B001", BookCount: 1, Price: 50},
+		},
+	}
+	resp, err := server.CreateCustomerOrder(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	var updatedCustomer models.Customer
+	db.First(&updatedCustomer, customer.ID)
+	assert.Equal(t, int32(1000-210*85/100), updatedCustomer.AccountBalance)
+
+	var books []models.Book
+	db.Order("book_no").Find(&books)
+	assert.Equal(t, int32(7), books[0].StockQuantity)
+	assert.Equal(t, int32(0), books[1].StockQuantity)
+
+	getResp, err := server.GetCustomerOrder(context.Background(), &pb.GetCustomerOrderRequest{Start: 0, Stop: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(getResp.CustomerOrders))
+	order := getResp.CustomerOrders[0]
+	assert.Equal(t, resp.Id, order.Id)
+	assert.Equal(t, int32(210), order.Price)
+	assert.Equal(t, int32(210*85/100), order.PaidAmount)
+	assert.Equal(t, 3, len(order.Items))
+	assert.Equal(t, "", order.BookNo)
+
+	// 任意一本书库存不足时整单失败，不扣款也不扣库存
+	req.Items = []*pb.CustomerOrderItem{
+		{BookNo: "B001", BookCount: 1, Price: 50},
+		{BookNo: "B002", BookCount: 2, Price: 120},
+	}
+	_, err = server.CreateCustomerOrder(context.Background(), req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	db.First(&updatedCustomer, customer.ID)
+	assert.Equal(t, int32(1000-210*85/100), updatedCustomer.AccountBalance)
+	db.Order("book_no").Find(&books)
+	assert.Equal(t, int32(7), books[0].StockQuantity)
+
+	var stockRequest models.StockRequest
+	db.First(&stockRequest, "book_no = ?", "B002")
+	assert.Equal(t, int32(2), stockRequest.Quantity)
+
+	var orderCount int64
+	db.Model(&models.CustomerOrder{}).Count(&orderCount)
+	assert.Equal(t, int64(1), orderCount)
 }
 
 func TestGetCustomerOrder(t *testing.T) {
-	db := setupTestDBCustomerOrder(t)
+	db := setupTestDB(t)
 	server := NewCustomerOrderServiceServer(db)
 
 	// 添加客户订单
 	order := models.CustomerOrder{
 		OrderDate:        "2023-01-01",
 		CustomerOnlineID: "customer1",
-		BookNo:           "B001",
-		BookCount:        5,
 		Price:            100,
 		Address:          "Address 1",
 		Status:           "未发货",
+		Items:            []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 5, Price: 100}},
 	}
 	db.Create(&order)
 
@@ -150,30 +233,38 @@ func TestGetCustomerOrder(t *testing.T) {
 	assert.True(t, resp.Success)
 	assert.Equal(t, 1, len(resp.CustomerOrders))
 	assert.Equal(t, "B001", resp.CustomerOrders[0].BookNo)
+	assert.Equal(t, 1, len(resp.CustomerOrders[0].Items))
+	assert.Equal(t, int32(5), resp.CustomerOrders[0].Items[0].BookCount)
 }
 
 func TestUpdateCustomerOrder(t *testing.T) {
-	db := setupTestDBCustomerOrder(t)
+	db := setupTestDB(t)
 	server := NewCustomerOrderServiceServer(db)
 
 	// 添加客户订单
 	order := models.CustomerOrder{
 		OrderDate:        "2023-01-01",
 		CustomerOnlineID: "customer1",
-		BookNo:           "B001",
-		BookCount:        5,
 		Price:            100,
 		Address:          "Address 1",
 		Status:           "未发货",
+		Items:            []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 5, Price: 100}},
 	}
 	db.Create(&order)
 
-	// 更新客户订单
-	req := &pb.UpdateCustomerOrderRequest{
+	// 已付款的订单已经扣除了库存和余额，不能再修改数量和价格
+	_, err := server.UpdateCustomerOrder(context.Background(), &pb.UpdateCustomerOrderRequest{
 		Id:        order.ID,
 		BookCount: 10,
 		Price:     200,
-		Status:    "已发货",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 更新客户订单
+	req := &pb.UpdateCustomerOrderRequest{
+		Id:      order.ID,
+		Address: "Address 2",
+		Status:  "已发货",
 	}
 	resp, err := server.UpdateCustomerOrder(context.Background(), req)
 	assert.NoError(t, err)
@@ -182,25 +273,112 @@ func TestUpdateCustomerOrder(t *testing.T) {
 
 	// 验证订单更新
 	var updatedOrder models.CustomerOrder
-	db.First(&updatedOrder, order.ID)
-	assert.Equal(t, int32(10), updatedOrder.BookCount)
-	assert.Equal(t, int32(200), updatedOrder.Price)
-	assert.Equal(t, "已发货", updatedOrder.Status)
+	db.Preload("Items").First(&updatedOrder, order.ID)
+	assert.Equal(t, int32(5), updatedOrder.Items[0].BookCount)
+	assert.Equal(t, int32(100), updatedOrder.Price)
+	assert.Equal(t, "Address 2", updatedOrder.Address)
+	assert.Equal(t, models.OrderStatusShipped, updatedOrder.Status)
+
+	// 未付款的单行订单可以修改数量和价格
+	pending := models.CustomerOrder{
+		OrderDate:        "2023-01-01",
+		CustomerOnlineID: "customer1",
+		Price:            100,
+		Address:          "Address 1",
+		Status:           models.OrderStatusPending,
+		Items:            []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 5, Price: 100}},
+	}
+	db.Create(&pending)
+	_, err = server.UpdateCustomerOrder(context.Background(), &pb.UpdateCustomerOrderRequest{
+		Id:        pending.ID,
+		BookCount: 10,
+		Price:     200,
+	})
+	assert.NoError(t, err)
+	var updatedPending models.CustomerOrder
+	db.Preload("Items").First(&updatedPending, pending.ID)
+	assert.Equal(t, int32(10), updatedPending.Items[0].BookCount)
+	assert.Equal(t, int32(200), updatedPending.Items[0].Price)
+	assert.Equal(t, int32(200), updatedPending.Price)
+	assert.Equal(t, models.OrderStatusPending, updatedPending.Status)
+}
+
+func TestUpdateCustomerOrderStatus(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewCustomerOrderServiceServer(db)
+
+	// 添加客户和书籍，通过 CreateCustomerOrder 创建已付款的订单
+	db.Create(&models.Customer{OnlineID: "customer1", Name: "Customer 1", AccountBalance: 1000, CreditLevel: 1})
+	db.Create(&models.Book{BookNo: "B001", Title: "Book Title 1", StockQuantity: 10})
+	createResp, err := server.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate:        "2023-01-01",
+		CustomerOnlineId: "customer1",
+		BookNo:           "B001",
+		BookCount:        1,
+		Price:            100,
+		Address:          "Address 1",
+	})
+	assert.NoError(t, err)
+	assert.True(t, createResp.Success)
+
+	// 已付款的订单不能直接变为已送达
+	_, err = server.UpdateCustomerOrder(context.Background(), &pb.UpdateCustomerOrderRequest{
+		Id:          createResp.Id,
+		OrderStatus: pb.OrderStatus_ORDER_STATUS_DELIVERED,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 未知的状态
+	_, err = server.UpdateCustomerOrder(context.Background(), &pb.UpdateCustomerOrderRequest{
+		Id:     createResp.Id,
+		Status: "lost",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 按流程发货、送达
+	for _, next := range []pb.OrderStatus{pb.OrderStatus_ORDER_STATUS_SHIPPED, pb.OrderStatus_ORDER_STATUS_DELIVERED} {
+		resp, err := server.UpdateCustomerOrder(context.Background(), &pb.UpdateCustomerOrderRequest{
+			Id:          createResp.Id,
+			OrderStatus: next,
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+	}
+
+	// 已送达的订单不能取消
+	_, err = server.UpdateCustomerOrder(context.Background(), &pb.UpdateCustomerOrderRequest{
+		Id:          createResp.Id,
+		OrderStatus: pb.OrderStatus_ORDER_STATUS_CANCELLED,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 每次状态变更都带有时间记录
+	getResp, err := server.GetCustomerOrder(context.Background(), &pb.GetCustomerOrderRequest{Start: 0, Stop: 0})
+	assert.NoError(t, err)
+	order := getResp.CustomerOrders[0]
+	assert.Equal(t, pb.OrderStatus_ORDER_STATUS_DELIVERED, order.OrderStatus)
+	assert.Equal(t, "delivered", order.Status)
+	assert.Equal(t, 3, len(order.StatusHistory))
+	assert.Equal(t, pb.OrderStatus_ORDER_STATUS_UNSPECIFIED, order.StatusHistory[0].FromStatus)
+	assert.Equal(t, pb.OrderStatus_ORDER_STATUS_PAID, order.StatusHistory[0].ToStatus)
+	assert.Equal(t, pb.OrderStatus_ORDER_STATUS_PAID, order.StatusHistory[1].FromStatus)
+	assert.Equal(t, pb.OrderStatus_ORDER_STATUS_SHIPPED, order.StatusHistory[1].ToStatus)
+	assert.Equal(t, pb.OrderStatus_ORDER_STATUS_DELIVERED, order.StatusHistory[2].ToStatus)
+	assert.NotNil(t, order.StatusHistory[1].ChangedAt)
 }
 
 func TestDeleteCustomerOrder(t *testing.T) {
-	db := setupTestDBCustomerOrder(t)
+	db := setupTestDB(t)
 	server := NewCustomerOrderServiceServer(db)
 
 	// 添加客户订单
 	order := models.CustomerOrder{
 		OrderDate:        "2023-01-01",
 		CustomerOnlineID: "customer1",
-		BookNo:           "B001",
-		BookCount:        5,
 		Price:            100,
 		Address:          "Address 1",
 		Status:           "未发货",
+		Items:            []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 5, Price: 100}},
 	}
 	db.Create(&order)
 
@@ -218,4 +396,292 @@ func TestDeleteCustomerOrder(t *testing.T) {
 	err = db.First(&deletedOrder, order.ID).Error
 	assert.Error(t, err)
 	assert.Equal(t, gorm.ErrRecordNotFound, err)
+
+	// 验证订单明细删除
+	var itemCount int64
+	db.Model(&models.CustomerOrderItem{}).Where("order_id = ?", order.ID).Count(&itemCount)
+	assert.Equal(t, int64(0), itemCount)
+}
+
+func TestCancelCustomerOrder(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewCustomerOrderServiceServer(db)
+
+	// 添加客户和书籍
+	customer := models.Customer{OnlineID: "customer1", Name: "Customer 1", AccountBalance: 1000, CreditLevel: 1}
+	db.Create(&customer)
+	db.Create(&models.Book{BookNo: "B001", Title: "Book Title 1", StockQuantity: 10})
+	db.Create(&models.Book{BookNo: "B002", Title: "Book Title 2", StockQuantity: 10})
+
+	createOrder := func() int32 {
+		resp, err := server.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+			OrderDate:        "2023-01-01",
+			CustomerOnlineId: "customer1",
+			Address:          "Address 1",
+			Items: []*pb.CustomerOrderItem{
+				{BookNo: "B001", BookCount: 2, Price: 100},
+				{BookNo: "B002", BookCount: 3, Price: 200},
+			},
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+		return resp.Id
+	}
+
+	// 取消已付款的订单，退还折扣后的金额并恢复库存
+	orderID := createOrder()
+	resp, err := server.CancelCustomerOrder(context.Background(), &pb.CancelCustomerOrderRequest{Id: orderID})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int32(270), resp.RefundAmount)
+
+	var updatedCustomer models.Customer
+	db.First(&updatedCustomer, customer.ID)
+	assert.Equal(t, int32(1000), updatedCustomer.AccountBalance)
+
+	var books []models.Book
+	db.Order("book_no").Find(&books)
+	assert.Equal(t, int32(10), books[0].StockQuantity)
+	assert.Equal(t, int32(10), books[1].StockQuantity)
+
+	var cancelledOrder models.CustomerOrder
+	db.First(&cancelledOrder, orderID)
+	assert.Equal(t, models.OrderStatusCancelled, cancelledOrder.Status)
+	assert.Equal(t, int32(270), cancelledOrder.RefundAmount)
+
+	// 不能重复取消
+	_, err = server.CancelCustomerOrder(context.Background(), &pb.CancelCustomerOrderRequest{Id: orderID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 已发货的订单不能取消，也不会退款
+	orderID = createOrder()
+	_, err = server.UpdateCustomerOrder(context.Background(), &pb.UpdateCustomerOrderRequest{
+		Id:          orderID,
+		OrderStatus: pb.OrderStatus_ORDER_STATUS_SHIPPED,
+	})
+	assert.NoError(t, err)
+	_, err = server.CancelCustomerOrder(context.Background(), &pb.CancelCustomerOrderRequest{Id: orderID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	db.First(&updatedCustomer, customer.ID)
+	assert.Equal(t, int32(730), updatedCustomer.AccountBalance)
+
+	// 不存在的订单
+	_, err = server.CancelCustomerOrder(context.Background(), &pb.CancelCustomerOrderRequest{Id: 100})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCancelLegacyCustomerOrder(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewCustomerOrderServiceServer(db)
+
+	// 模拟旧版本创建的订单：信用等级 2 的客户按 85 折扣款，订单只记录了总价
+	customer := models.Customer{OnlineID: "customer1", Name: "Customer 1", AccountBalance: 1000, CreditLevel: 2}
+	db.Create(&customer)
+	db.Create(&models.Book{BookNo: "B001", Title: "Book Title 1", StockQuantity: 8})
+	legacyOrder := models.CustomerOrder{
+		OrderDate:        "2023-01-01",
+		CustomerOnlineID: "customer1",
+		Price:            200,
+		Address:          "Address 1",
+		Status:           models.OrderStatusPaid,
+		Items:            []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 2, Price: 200}},
+	}
+	db.Create(&legacyOrder)
+
+	// 重新执行版本 16 的回填
+	assert.NoError(t, db.Where("version = ?", 16).Delete(&migrations.SchemaMigration{}).Error)
+	assert.NoError(t, migrations.Up(db))
+
+	// 取消时只退还当初扣除的折扣价
+	resp, err := server.CancelCustomerOrder(context.Background(), &pb.CancelCustomerOrderRequest{Id: legacyOrder.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(170), resp.RefundAmount)
+
+	var updatedCustomer models.Customer
+	db.First(&updatedCustomer, customer.ID)
+	assert.Equal(t, int32(1170), updatedCustomer.AccountBalance)
+	var book models.Book
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(10), book.StockQuantity)
+}
+
+func TestCreateCustomerOrderBackorder(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewCustomerOrderServiceServer(db)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+
+	// 添加客户和库存不足的书籍
+	db.Create(&models.Customer{OnlineID: "customer1", Name: "Customer 1", AccountBalance: 1000, CreditLevel: 1})
+	db.Create(&models.Customer{OnlineID: "customer2", Name: "Customer 2", AccountBalance: 1000, CreditLevel: 1})
+	db.Create(&models.Book{BookNo: "B001", Title: "Book Title 1", StockQuantity: 2})
+	db.Create(&models.Book{BookNo: "B002", Title: "Book Title 2", StockQuantity: 5})
+	db.Create(&models.Book{BookNo: "B003", Title: "Book Title 3", StockQuantity: 1})
+
+	// 允许缺货时保留有货的部分，整单扣款
+	resp, err := server.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate:        "2023-01-01",
+		CustomerOnlineId: "customer1",
+		Address:          "Address 1",
+		AllowBackorder:   true,
+		Items: []*pb.CustomerOrderItem{
+			{BookNo: "B001", BookCount: 5, Price: 500},
+			{BookNo: "B002", BookCount: 1, Price: 100},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Customer order created successfully, some books are backordered", resp.Feedback)
+	orderID := resp.Id
+
+	var order models.CustomerOrder
+	db.Preload("Items", orderByID).First(&order, orderID)
+	assert.Equal(t, models.OrderStatusBackordered, order.Status)
+	assert.Equal(t, int32(540), order.PaidAmount)
+	assert.Equal(t, int32(3), order.Items[0].BackorderedCount)
+	assert.Equal(t, int32(0), order.Items[1].BackorderedCount)
+	var books []models.Book
+	db.Order("book_no").Find(&books)
+	assert.Equal(t, int32(0), books[0].StockQuantity)
+	assert.Equal(t, int32(4), books[1].StockQuantity)
+
+	// 缺货的部分创建对应订单的缺书登记
+	var stockRequest models.StockRequest
+	assert.NoError(t, db.First(&stockRequest, "book_no = ?", "B001").Error)
+	assert.Equal(t, int32(3), stockRequest.Quantity)
+	assert.Equal(t, orderID, *stockRequest.CustomerOrderID)
+
+	// 缺货订单不能手动变为 ready
+	_, err = server.UpdateCustomerOrder(context.Background(), &pb.UpdateCustomerOrderRequest{Id: orderID, OrderStatus: pb.OrderStatus_ORDER_STATUS_READY})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 第二位客户不允许缺货，排在缺货订单之后等待
+	_, err = server.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate:        "2023-01-01",
+		CustomerOnlineId: "customer2",
+		Address:          "Address 2",
+		Items:            []*pb.CustomerOrderItem{{BookNo: "B001", BookCount: 1, Price: 100}},
+		AutoFulfill:      true,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 到货数量不够缺货订单时，后面的客户也继续等待
+	receive := func(quantity int32) string {
+		purchaseOrder := &models.PurchaseOrder{OrderDate: "2023-01-02", Items: []*models.PurchaseOrderItem{{BookNo: "B001", Title: "Book Title 1", Quantity: quantity}}}
+		db.Create(purchaseOrder)
+		resp, err := purchaseOrderServer.UpdatePurchaseOrder(context.Background(), &pb.UpdatePurchaseOrderRequest{Id: purchaseOrder.ID, Finished: true})
+		assert.NoError(t, err)
+		return resp.Feedback
+	}
+	assert.Contains(t, receive(2), "已为0位等待到货的客户自动下单，为0个缺货订单保留了库存，通知了0位客户")
+	db.First(&order, orderID)
+	assert.Equal(t, models.OrderStatusBackordered, order.Status)
+
+	// 再次到货后订单备齐变为 ready，剩下的库存为第二位客户下单
+	assert.Contains(t, receive(2), "已为1位等待到货的客户自动下单，为1个缺货订单保留了库存，通知了0位客户")
+	db.Preload("Items", orderByID).Preload("StatusHistory", orderByID).First(&order, orderID)
+	assert.Equal(t, models.OrderStatusReady, order.Status)
+	assert.Equal(t, int32(0), order.Items[0].BackorderedCount)
+	assert.Equal(t, models.OrderStatusBackordered, order.StatusHistory[1].FromStatus)
+	var book models.Book
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(0), book.StockQuantity)
+
+	// 取消备齐的订单时恢复全部库存
+	cancelResp, err := server.CancelCustomerOrder(context.Background(), &pb.CancelCustomerOrderRequest{Id: orderID})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(540), cancelResp.RefundAmount)
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(5), book.StockQuantity)
+
+	// 取消仍在缺货的订单只恢复已经保留的库存，不再等待到货
+	resp, err = server.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate:        "2023-01-01",
+		CustomerOnlineId: "customer2",
+		Address:          "Address 2",
+		AllowBackorder:   true,
+		Items:            []*pb.CustomerOrderItem{{BookNo: "B003", BookCount: 3, Price: 300}},
+	})
+	assert.NoError(t, err)
+	_, err = server.CancelCustomerOrder(context.Background(), &pb.CancelCustomerOrderRequest{Id: resp.Id})
+	assert.NoError(t, err)
+	var book3 models.Book
+	db.First(&book3, "book_no = ?", "B003")
+	assert.Equal(t, int32(1), book3.StockQuantity)
+	var waitlist models.StockWaitlist
+	db.First(&waitlist, "customer_order_id = ?", resp.Id)
+	assert.Equal(t, models.WaitlistCancelled, waitlist.Status)
+}
+
+func TestCreateCustomerOrderConcurrent(t *testing.T) {
+	// 并发测试需要真正的文件数据库，_txlock=immediate 让 SQLite 的写事务串行执行
+	dsn := fmt.Sprintf("file:%s?_txlock=immediate&_busy_timeout=10000", filepath.Join(t.TempDir(), "orders.db"))
+	db := openTestDB(t, dsn)
+	server := NewCustomerOrderServiceServer(db)
+
+	// 添加客户
+	customer := models.Customer{
+		OnlineID:       "customer1",
+		Password:       "password",
+		Name:           "Customer 1",
+		Address:        "Address 1",
+		AccountBalance: 10000,
+		CreditLevel:    1,
+	}
+	db.Create(&customer)
+
+	// 添加书籍，库存只有 5 本
+	book := models.Book{
+		BookNo:        "B001",
+		Title:         "Book Title 1",
+		PublisherName: "Test Publisher",
+		Authors:       "Test Author",
+		StockQuantity: 5,
+	}
+	db.Create(&book)
+
+	// 并发下 20 个订单，每单 1 本
+	const orders = 20
+	var wg sync.WaitGroup
+	var succeeded atomic.Int32
+	for i := 0; i < orders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := &pb.CreateCustomerOrderRequest{
+				OrderDate:        "2023-01-01",
+				CustomerOnlineId: "customer1",
+				BookNo:           "B001",
+				BookCount:        1,
+				Price:            100,
+				Address:          "Address 1",
+				Status:           "未发货",
+			}
+			// 库存不足的订单返回 FailedPrecondition
+			resp, err := server.CreateCustomerOrder(context.Background(), req)
+			if err != nil {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return
+			}
+			if resp.Success {
+				succeeded.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 只有 5 个订单成功，库存恰好为 0
+	assert.Equal(t, int32(5), succeeded.Load())
+
+	var updatedBook models.Book
+	db.First(&updatedBook, "book_no = ?", "B001")
+	assert.Equal(t, int32(0), updatedBook.StockQuantity)
+
+	// 余额只扣除成功订单的金额
+	var updatedCustomer models.Customer
+	db.First(&updatedCustomer, "online_id = ?", "customer1")
+	assert.Equal(t, int32(10000-5*90), updatedCustomer.AccountBalance)
+
+	var orderCount int64
+	db.Model(&models.CustomerOrder{}).Count(&orderCount)
+	assert.Equal(t, int64(5), orderCount)
 }
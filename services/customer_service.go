@@ -2,11 +2,14 @@ package services
 
 import (
 	"context"
-	"fmt"
+	"net/mail"
 	"time"
+	"unicode"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
@@ -30,9 +33,10 @@ func NewCustomerServiceServer(db *gorm.DB) *CustomerServiceServer {
 	// 初始化虚拟客户
 	var virtualCustomer models.Customer
 	if err := db.Where("name = ?", "virtual").First(&virtualCustomer).Error; err == gorm.ErrRecordNotFound {
+		// 虚拟客户不能登录，不设置密码
 		virtualCustomer = models.Customer{
 			OnlineID:       "virtual",
-			Password:       "virtual",
+			Password:       "",
 			Name:           "virtual",
 			Address:        "virtual",
 			AccountBalance: 0,
@@ -51,51 +55,79 @@ func NewCustomerServiceServer(db *gorm.DB) *CustomerServiceServer {
 func (s *CustomerServiceServer) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CreateCustomerResponse, error) {
 	// 验证用户输入
 	if req.GetOnlineId() == "" {
-		return &pb.CreateCustomerResponse{
-			Success:  false,
-			Feedback: "Online ID is required",
-		}, nil
+		return nil, invalidArgumentError("online_id", "Online ID is required")
 	}
 
 	if req.GetPassword() == "" {
-		return &pb.CreateCustomerResponse{
-			Success:  false,
-			Feedback: "Password is required",
-		}, nil
+		return nil, invalidArgumentError("password", "Password is required")
 	}
 
 	if req.GetName() == "" {
-		return &pb.CreateCustomerResponse{
-			Success:  false,
-			Feedback: "Name is required",
-		}, nil
+		return nil, invalidArgumentError("name", "Name is required")
 	}
 
 	if req.GetAddress() == "" {
-		return &pb.CreateCustomerResponse{
-			Success:  false,
-			Feedback: "Address is required",
-		}, nil
+		return nil, invalidArgumentError("address", "Address is required")
+	}
+
+	// 验证联系方式和通知偏好
+	if err := validateContacts(req.GetEmail(), req.GetPhone()); err != nil {
+		return nil, err
+	}
+	var preferences *models.NotificationPreferences
+	if req.GetNotificationPreferences() != nil {
+		var err error
+		preferences, err = fromPbNotificationPreferences(req.GetNotificationPreferences(), req.GetEmail(), req.GetPhone())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 自助注册的客户不能设置余额和信用等级，只有店员和管理员可以设置
+	if err := validateCreditLevel(req.GetCreditLevel()); err != nil {
+		return nil, err
+	}
+	if (req.GetAccountBalance() != 0 || req.GetCreditLevel() != 0) && !canManageAccount(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "Only clerks and admins can set account balance or credit level")
+	}
+
+	// 只有管理员可以创建员工账号
+	role, err := requestedRole(ctx, req.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	if role == "" {
+		role = RoleCustomer
+	}
+
+	// 计算密码哈希
+	passwordHash, err := hashPassword(req.GetPassword())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
 	}
 
 	// 构建新的 Customer 对象
 	customer := &models.Customer{
-		OnlineID:       req.GetOnlineId(),
-		Password:       req.GetPassword(),
-		Name:           req.GetName(),
-		Address:        req.GetAddress(),
-		AccountBalance: req.GetAccountBalance(),
-		CreditLevel:    req.GetCreditLevel(),
-		CreatedAt:      time.Now(),
-		UpdatedAt:      time.Now(),
+		OnlineID:                req.GetOnlineId(),
+		Password:                passwordHash,
+		Role:                    role,
+		Name:                    req.GetName(),
+		Address:                 req.GetAddress(),
+		AccountBalance:          req.GetAccountBalance(),
+		CreditLevel:             req.GetCreditLevel(),
+		Email:                   req.GetEmail(),
+		Phone:                   req.GetPhone(),
+		CreatedAt:               time.Now(),
+		UpdatedAt:               time.Now(),
+		NotificationPreferences: preferences,
 	}
 
 	// 写入数据库
 	if err := s.db.Create(&customer).Error; err != nil {
-		return &pb.CreateCustomerResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to create customer: %v", err),
-		}, nil
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "Customer already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create customer: %v", err)
 	}
 
 	// 返回成功的响应
@@ -105,32 +137,32 @@ func (s *CustomerServiceServer) CreateCustomer(ctx context.Context, req *pb.Crea
 	}, nil
 }
 
-// GetCustomer 获取客户详细信息
+// GetCustomer 获取客户详细信息，支持游标分页和按创建时间过滤
 func (s *CustomerServiceServer) GetCustomer(ctx context.Context, req *pb.GetCustomerRequest) (*pb.GetCustomerResponse, error) {
-	// 验证请求参数
-	if req.GetStart() < 0 || req.GetStop() < req.GetStart() {
-		return &pb.GetCustomerResponse{
-			Success:  false,
-			Feedback: "Invalid range: start must be >= 0 and stop must be >= start",
-		}, nil
+	// 验证过滤条件
+	created, err := createdBetween(req.GetCreatedAfter(), req.GetCreatedBefore())
+	if err != nil {
+		return nil, err
 	}
 
-	// 查询客户，排除虚拟客户
-	var customers []*models.Customer
-	if err := s.db.Where("name != ?", "virtual").Offset(int(req.GetStart())).Limit(int(req.GetStop() - req.GetStart() + 1)).Find(&customers).Error; err != nil {
-		return &pb.GetCustomerResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query customers: %v", err),
-		}, nil
+	// 查询客户，排除虚拟客户，客户只能查询自己
+	query := s.db.Where("name != ?", "virtual").Scopes(created)
+	if onlineID, ok := customerScope(ctx); ok {
+		query = query.Where("online_id = ?", onlineID)
+	}
+	scope := pageScope("customers", req.GetCreatedAfter(), req.GetCreatedBefore())
+	result, err := paginate(query, req, scope, func(customer *models.Customer) (time.Time, int32) {
+		return customer.CreatedAt, customer.ID
+	})
+	if err != nil {
+		return nil, err
 	}
+	customers := result.Items
 
 	// 查询虚拟客户
 	var virtualCustomer models.Customer
 	if err := s.db.Where("name = ?", "virtual").First(&virtualCustomer).Error; err != nil {
-		return &pb.GetCustomerResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query virtual customer: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "Failed to query virtual customer: %v", err)
 	}
 
 	// 更新客户信用等级
@@ -168,15 +200,18 @@ func (s *CustomerServiceServer) GetCustomer(ctx context.Context, req *pb.GetCust
 	var pbCustomers []*pb.Customer
 	for _, customer := range customers {
 		pbCustomer := &pb.Customer{
-			Id:             customer.ID,
-			OnlineId:       customer.OnlineID,
-			Password:       customer.Password,
-			Name:           customer.Name,
-			Address:        customer.Address,
-			AccountBalance: customer.AccountBalance,
-			CreditLevel:    customer.CreditLevel,
-			CreatedAt:      timestamppb.New(customer.CreatedAt),
-			UpdatedAt:      timestamppb.New(customer.UpdatedAt),
+			Id:                      customer.ID,
+			OnlineId:                customer.OnlineID,
+			Name:                    customer.Name,
+			Address:                 customer.Address,
+			AccountBalance:          customer.AccountBalance,
+			CreditLevel:             customer.CreditLevel,
+			Role:                    customer.Role,
+			Email:                   customer.Email,
+			Phone:                   customer.Phone,
+			CreatedAt:               timestamppb.New(customer.CreatedAt),
+			UpdatedAt:               timestamppb.New(customer.UpdatedAt),
+			NotificationPreferences: toPbNotificationPreferences(customer.NotificationPreferences),
 		}
 
 		pbCustomers = append(pbCustomers, pbCustomer)
@@ -184,9 +219,11 @@ func (s *CustomerServiceServer) GetCustomer(ctx context.Context, req *pb.GetCust
 
 	// 返回响应
 	return &pb.GetCustomerResponse{
-		Success:   true,
-		Feedback:  "Customers retrieved successfully",
-		Customers: pbCustomers,
+		Success:       true,
+		Feedback:      "Customers retrieved successfully",
+		Customers:     pbCustomers,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    result.TotalCount,
 	}, nil
 }
 
@@ -197,23 +234,44 @@ func (s *CustomerServiceServer) UpdateCustomer(ctx context.Context, req *pb.Upda
 	// 查询客户
 	if err := s.db.First(&customer, req.GetId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.UpdateCustomerResponse{
-				Success:  false,
-				Feedback: "Customer not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to query customer: %v", err)
+	}
+
+	// 客户只能修改自己的资料，不能修改在线ID、余额和信用等级
+	if onlineID, ok := customerScope(ctx); ok {
+		if customer.OnlineID != onlineID {
+			return nil, status.Error(codes.PermissionDenied, "Customers can only update their own profile")
+		}
+		if (req.GetOnlineId() != "" && req.GetOnlineId() != customer.OnlineID) || req.GetAccountBalance() != 0 || req.GetCreditLevel() != 0 {
+			return nil, status.Error(codes.PermissionDenied, "Customers cannot change online ID, account balance or credit level")
 		}
-		return &pb.UpdateCustomerResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query customer: %v", err),
-		}, nil
+	}
+
+	if err := validateCreditLevel(req.GetCreditLevel()); err != nil {
+		return nil, err
+	}
+
+	// 只有管理员可以修改角色
+	role, err := requestedRole(ctx, req.GetRole())
+	if err != nil {
+		return nil, err
 	}
 
 	// 更新字段
+	if role != "" {
+		customer.Role = role
+	}
 	if req.GetOnlineId() != "" {
 		customer.OnlineID = req.GetOnlineId()
 	}
 	if req.GetPassword() != "" {
-		customer.Password = req.GetPassword()
+		passwordHash, err := hashPassword(req.GetPassword())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+		}
+		customer.Password = passwordHash
 	}
 	if req.GetName() != "" {
 		customer.Name = req.GetName()
@@ -227,14 +285,30 @@ func (s *CustomerServiceServer) UpdateCustomer(ctx context.Context, req *pb.Upda
 	if req.GetCreditLevel() != 0 {
 		customer.CreditLevel = req.GetCreditLevel()
 	}
+	if err := validateContacts(req.GetEmail(), req.GetPhone()); err != nil {
+		return nil, err
+	}
+	if req.GetEmail() != "" {
+		customer.Email = req.GetEmail()
+	}
+	if req.GetPhone() != "" {
+		customer.Phone = req.GetPhone()
+	}
+	if req.GetNotificationPreferences() != nil {
+		preferences, err := fromPbNotificationPreferences(req.GetNotificationPreferences(), customer.Email, customer.Phone)
+		if err != nil {
+			return nil, err
+		}
+		customer.NotificationPreferences = preferences
+	}
 	customer.UpdatedAt = time.Now()
 
 	// 保存更新
 	if err := s.db.Save(&customer).Error; err != nil {
-		return &pb.UpdateCustomerResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to update customer: %v", err),
-		}, nil
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "Customer already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update customer: %v", err)
 	}
 
 	// 返回成功的响应
@@ -251,23 +325,14 @@ func (s *CustomerServiceServer) DeleteCustomer(ctx context.Context, req *pb.Dele
 	// 查询客户
 	if err := s.db.First(&customer, req.GetId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.DeleteCustomerResponse{
-				Success:  false,
-				Feedback: "Customer not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Customer not found")
 		}
-		return &pb.DeleteCustomerResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query customer: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query customer: %v", err)
 	}
 
 	// 删除客户记录
 	if err := s.db.Delete(&customer).Error; err != nil {
-		return &pb.DeleteCustomerResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to delete customer: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to delete customer: %v", err)
 	}
 
 	// 返回成功的响应
@@ -276,3 +341,50 @@ func (s *CustomerServiceServer) DeleteCustomer(ctx context.Context, req *pb.Dele
 		Feedback: "Customer deleted successfully",
 	}, nil
 }
+
+// requestedRole 验证请求中的角色，设置客户以外的角色需要管理员权限，未设置时返回空字符串
+func requestedRole(ctx context.Context, role string) (string, error) {
+	if role == "" {
+		return "", nil
+	}
+	if !validRoles[role] {
+		return "", invalidArgumentError("role", "Role must be one of customer, clerk, purchasing or admin")
+	}
+	if role != RoleCustomer && !canAssignRole(ctx) {
+		return "", status.Error(codes.PermissionDenied, "Only admins can assign roles")
+	}
+	return role, nil
+}
+
+// validateCreditLevel 验证信用等级，等级用作折扣和透支额度表的下标
+func validateCreditLevel(level int32) error {
+	if level < 0 || int(level) >= len(discount) {
+		return invalidArgumentError("credit_level", "Credit level must be between 0 and 5")
+	}
+	return nil
+}
+
+// validateContacts 验证邮箱地址和手机号，为空的不验证
+func validateContacts(email, phone string) error {
+	if email != "" {
+		if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+			return invalidArgumentError("email", "Email must be a valid address like name@example.com")
+		}
+	}
+	if phone != "" {
+		digits := 0
+		for _, r := range phone {
+			switch {
+			case unicode.IsDigit(r):
+				digits++
+			case r == '+' || r == '-' || r == ' ':
+			default:
+				return invalidArgumentError("phone", "Phone may only contain digits, spaces, '+' and '-'")
+			}
+		}
+		if digits < 5 || digits > 15 {
+			return invalidArgumentError("phone", "Phone must have 5 to 15 digits")
+		}
+	}
+	return nil
+}
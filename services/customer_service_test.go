@@ -7,21 +7,12 @@ import (
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/notification"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
 )
 
-func setupTestDBCustomerService(t *testing.T) *gorm.DB {
-	db, _ := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err := models.AutoMigrate(db); err != nil {
-		t.Fatalf("failed to migrate database: %v", err)
-	}
-	return db
-}
-
 func TestCustomerService(t *testing.T) {
-	db := setupTestDBCustomerService(t)
+	db := setupTestDB(t)
 	server := NewCustomerServiceServer(db)
 
 	// 添加一些客户
@@ -54,7 +45,13 @@ func TestCustomerService(t *testing.T) {
 	expectedCreditLevels := []int32{0, 0, 0, 0, 0} // 根据初始余额 100, 200, 300, 400, 500
 	for i, customer := range getResp.Customers {
 		assert.Equal(t, fmt.Sprintf("online_id_%d", i+1), customer.OnlineId)
-		assert.Equal(t, "password", customer.Password)
+		// 密码以哈希保存，不会出现在应答中
+		var stored models.Customer
+		db.First(&stored, customer.Id)
+		assert.NotEqual(t, "password", stored.Password)
+		match, hashed := checkPassword(stored.Password, "password")
+		assert.True(t, match)
+		assert.True(t, hashed)
 		assert.Equal(t, fmt.Sprintf("Customer %d", i+1), customer.Name)
 		assert.Equal(t, fmt.Sprintf("Address %d", i+1), customer.Address)
 		assert.Equal(t, int32(100*(i+1)), customer.AccountBalance)
@@ -88,7 +85,10 @@ func TestCustomerService(t *testing.T) {
 
 	updatedCustomer := getResp.Customers[0]
 	assert.Equal(t, "updated_online_id", updatedCustomer.OnlineId)
-	assert.Equal(t, "updated_password", updatedCustomer.Password)
+	var stored models.Customer
+	db.First(&stored, updatedCustomer.Id)
+	match, _ := checkPassword(stored.Password, "updated_password")
+	assert.True(t, match)
 	assert.Equal(t, "Updated Customer", updatedCustomer.Name)
 	assert.Equal(t, "Updated Address", updatedCustomer.Address)
 	assert.Equal(t, int32(3500), updatedCustomer.AccountBalance)
@@ -113,3 +113,71 @@ func TestCustomerService(t *testing.T) {
 	assert.True(t, getResp.Success)
 	assert.Equal(t, 4, len(getResp.Customers))
 }
+
+func TestCustomerNotificationPreferences(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewCustomerServiceServer(db)
+
+	// 不填写通知偏好时通过邮件接收所有通知
+	_, err := server.CreateCustomer(context.Background(), &pb.CreateCustomerRequest{
+		OnlineId: "customer1",
+		Password: "password",
+		Name:     "Customer 1",
+		Address:  "Address 1",
+		Email:    "customer1@example.com",
+	})
+	assert.NoError(t, err)
+	getResp, err := server.GetCustomer(context.Background(), &pb.GetCustomerRequest{Start: 0, Stop: 0})
+	assert.NoError(t, err)
+	customer := getResp.Customers[0]
+	assert.Equal(t, "customer1@example.com", customer.Email)
+	assert.Equal(t, []pb.NotificationChannel{pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL}, customer.NotificationPreferences.Channels)
+
+	// 修改手机号和通知偏好
+	_, err = server.UpdateCustomer(context.Background(), &pb.UpdateCustomerRequest{
+		Id:    customer.Id,
+		Phone: "+86 138-0000-0000",
+		NotificationPreferences: &pb.NotificationPreferences{
+			Channels: []pb.NotificationChannel{pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS},
+			Events:   []*pb.NotificationEventPreference{{Event: notification.EventBookAvailable, Enabled: false}},
+		},
+	})
+	assert.NoError(t, err)
+	var stored models.Customer
+	db.First(&stored, customer.Id)
+	assert.Equal(t, "customer1@example.com", stored.Email)
+	assert.Equal(t, []string{models.NotificationChannelSMS}, stored.NotificationPreferences.Channels)
+	assert.Empty(t, stored.NotificationChannels(notification.EventBookAvailable))
+
+	// 无效的联系方式和通知偏好
+	tests := []struct {
+		name  string
+		req   *pb.UpdateCustomerRequest
+		field string
+	}{
+		{"email", &pb.UpdateCustomerRequest{Id: customer.Id, Email: "not an email"}, "email"},
+		{"phone", &pb.UpdateCustomerRequest{Id: customer.Id, Phone: "call me"}, "phone"},
+		{"channel", &pb.UpdateCustomerRequest{Id: customer.Id, NotificationPreferences: &pb.NotificationPreferences{
+			Channels: []pb.NotificationChannel{pb.NotificationChannel(99)},
+		}}, "notification_preferences.channels"},
+		{"event", &pb.UpdateCustomerRequest{Id: customer.Id, NotificationPreferences: &pb.NotificationPreferences{
+			Events: []*pb.NotificationEventPreference{{Event: "unknown"}},
+		}}, "notification_preferences.events"},
+	}
+	for _, test := range tests {
+		_, err := server.UpdateCustomer(context.Background(), test.req)
+		assert.Equal(t, test.field, badRequestField(t, err), test.name)
+	}
+
+	// 选择短信渠道需要手机号
+	_, err = server.CreateCustomer(context.Background(), &pb.CreateCustomerRequest{
+		OnlineId: "customer2",
+		Password: "password",
+		Name:     "Customer 2",
+		Address:  "Address 2",
+		NotificationPreferences: &pb.NotificationPreferences{
+			Channels: []pb.NotificationChannel{pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS},
+		},
+	})
+	assert.Equal(t, "notification_preferences.channels", badRequestField(t, err))
+}
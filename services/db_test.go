@@ -0,0 +1,28 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoldenStain/goDB/migrations"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTestDB 为每个测试打开独立的内存数据库，并执行全部迁移
+func setupTestDB(t *testing.T) *gorm.DB {
+	return openTestDB(t, fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name()))
+}
+
+// openTestDB 按 dsn 打开 SQLite 数据库并执行全部迁移，与服务端一样开启错误转换，打开或迁移失败时测试立即失败
+func openTestDB(t *testing.T, dsn string) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{TranslateError: true})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	if err := migrations.Up(db); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	return db
+}
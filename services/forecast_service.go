@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"math"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 需求预测的默认参数
+const (
+	defaultMovingAverageDays = 28
+	defaultSmoothingDays     = 90
+	defaultSmoothingAlpha    = 0.3
+	defaultCoverDays         = 30
+)
+
+// forecastOrderStatuses 是计入销量的订单状态，已取消、已退货和未付款的订单不算
+var forecastOrderStatuses = []models.OrderStatus{
+	models.OrderStatusPaid,
+	models.OrderStatusShipped,
+	models.OrderStatusDelivered,
+	models.OrderStatusBackordered,
+	models.OrderStatusReady,
+}
+
+type ForecastServiceServer struct {
+	pb.UnimplementedForecastServiceServer
+	db *gorm.DB
+}
+
+// NewForecastServiceServer 用于创建 ForecastServiceServer
+func NewForecastServiceServer(db *gorm.DB) *ForecastServiceServer {
+	return &ForecastServiceServer{
+		db: db,
+	}
+}
+
+// forecastParams 是补上默认值之后的预测参数
+type forecastParams struct {
+	movingAverageDays int
+	smoothingDays     int
+	smoothingAlpha    float64
+	coverDays         int
+}
+
+// historyDays 返回需要查询的销量天数
+func (p *forecastParams) historyDays() int {
+	if p.smoothingDays > p.movingAverageDays {
+		return p.smoothingDays
+	}
+	return p.movingAverageDays
+}
+
+// parseForecastParams 验证预测参数，不填写的参数使用默认值
+func parseForecastParams(req *pb.ForecastDemandRequest) (*forecastParams, error) {
+	params := &forecastParams{
+		movingAverageDays: int(req.GetMovingAverageDays()),
+		smoothingDays:     int(req.GetSmoothingDays()),
+		smoothingAlpha:    req.GetSmoothingAlpha(),
+		coverDays:         int(req.GetCoverDays()),
+	}
+	if params.movingAverageDays < 0 {
+		return nil, invalidArgumentError("moving_average_days", "Moving average days cannot be negative")
+	}
+	if params.smoothingDays < 0 {
+		return nil, invalidArgumentError("smoothing_days", "Smoothing days cannot be negative")
+	}
+	if !(params.smoothingAlpha >= 0 && params.smoothingAlpha <= 1) {
+		return nil, invalidArgumentError("smoothing_alpha", "Smoothing alpha must be between 0 and 1")
+	}
+	if params.coverDays < 0 {
+		return nil, invalidArgumentError("cover_days", "Cover days cannot be negative")
+	}
+
+	if params.movingAverageDays == 0 {
+		params.movingAverageDays = defaultMovingAverageDays
+	}
+	if params.smoothingDays == 0 {
+		params.smoothingDays = defaultSmoothingDays
+	}
+	if params.smoothingAlpha == 0 {
+		params.smoothingAlpha = defaultSmoothingAlpha
+	}
+	if params.coverDays == 0 {
+		params.coverDays = defaultCoverDays
+	}
+	return params, nil
+}
+
+// ForecastDemand 按每日销量预测书籍的需求，给出建议的补货数量，需要时为建议补货的书创建缺书登记
+func (s *ForecastServiceServer) ForecastDemand(ctx context.Context, req *pb.ForecastDemandRequest) (*pb.ForecastDemandResponse, error) {
+	// 验证用户输入
+	params, err := parseForecastParams(req)
+	if err != nil {
+		return nil, err
+	}
+	db := s.db.WithContext(ctx)
+
+	// 查找书籍
+	var books []*models.Book
+	query := db.Order("book_no")
+	if len(req.GetBookNos()) > 0 {
+		query = query.Where("book_no IN ?", req.GetBookNos())
+	}
+	if err := query.Find(&books).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to query books: %v", err)
+	}
+	found := make(map[string]bool, len(books))
+	for _, book := range books {
+		found[book.BookNo] = true
+	}
+	for _, bookNo := range req.GetBookNos() {
+		if !found[bookNo] {
+			return nil, status.Errorf(codes.NotFound, "Book not found: %s", bookNo)
+		}
+	}
+
+	now := time.Now()
+	sales, err := dailySales(db, req.GetBookNos(), now, params.historyDays())
+	if err != nil {
+		return nil, err
+	}
+
+	var forecasts []*pb.BookForecast
+	forecastBooks := func(tx *gorm.DB) error {
+		forecasts = make([]*pb.BookForecast, 0, len(books))
+		for _, book := range books {
+			// 锁定书籍后重新计算在途数量再创建缺书登记，并发的预测不会为同一本书重复补货，
+			// 书籍按书号排列，加锁的顺序是固定的
+			if req.GetCreateStockRequests() {
+				var locked models.Book
+				if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&locked, book.ID).Error; err != nil {
+					return status.Errorf(codes.Internal, "Failed to query book %s: %v", book.BookNo, err)
+				}
+				book = &locked
+			}
+
+			daily := sales[book.BookNo]
+			if daily == nil {
+				daily = make([]int32, params.historyDays())
+			}
+			movingAverage := movingAverageDemand(daily, params.movingAverageDays)
+			smoothing := exponentialSmoothingDemand(daily, params.smoothingDays, params.smoothingAlpha)
+			demand := movingAverage
+			if smoothing > demand {
+				demand = smoothing
+			}
+			forecast := &pb.BookForecast{
+				BookNo:               book.BookNo,
+				Title:                book.Title,
+				StockQuantity:        book.StockQuantity,
+				AvailableQuantity:    book.AvailableQuantity(),
+				MovingAverage:        movingAverage,
+				ExponentialSmoothing: smoothing,
+				DailyDemand:          demand,
+			}
+			if demand > 0 {
+				daysOfCover := float64(book.AvailableQuantity()) / demand
+				forecast.DaysOfCover = &daysOfCover
+			}
+
+			incoming, suggested, err := suggestReorderQuantity(tx, book, demand, params.coverDays)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Failed to query incoming quantity of book %s: %v", book.BookNo, err)
+			}
+			forecast.IncomingQuantity = incoming
+			forecast.SuggestedQuantity = suggested
+			if req.GetCreateStockRequests() && suggested > 0 {
+				stockRequest, err := createStockRequest(tx, book, suggested, nil)
+				if err != nil {
+					return err
+				}
+				forecast.StockRequestId = stockRequest.ID
+			}
+			forecasts = append(forecasts, forecast)
+		}
+		return nil
+	}
+
+	// 创建缺书登记时所有书在同一个事务中处理，任何一本失败时都不创建
+	if req.GetCreateStockRequests() {
+		err = db.Transaction(forecastBooks)
+	} else {
+		err = forecastBooks(db)
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ForecastDemandResponse{
+		Success:   true,
+		Feedback:  "Demand forecast computed successfully",
+		Forecasts: forecasts,
+	}, nil
+}
+
+// dailySales 按书号汇总最近 days 天每天的销量，下标 0 是最近的 24 小时，bookNos 为空时汇总全部书籍
+func dailySales(db *gorm.DB, bookNos []string, now time.Time, days int) (map[string][]int32, error) {
+	var rows []struct {
+		BookNo    string
+		BookCount int32
+		CreatedAt time.Time
+	}
+	query := db.Table("customer_order_items").
+		Select("customer_order_items.book_no, customer_order_items.book_count, customer_orders.created_at").
+		Joins("JOIN customer_orders ON customer_orders.id = customer_order_items.order_id").
+		Where("customer_orders.status IN ? AND customer_orders.created_at > ?", forecastOrderStatuses, now.AddDate(0, 0, -days))
+	if len(bookNos) > 0 {
+		query = query.Where("customer_order_items.book_no IN ?", bookNos)
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to query sales: %v", err)
+	}
+
+	sales := make(map[string][]int32)
+	for _, row := range rows {
+		day := int(now.Sub(row.CreatedAt) / (24 * time.Hour))
+		if day < 0 {
+			day = 0
+		}
+		if day >= days {
+			continue
+		}
+		if sales[row.BookNo] == nil {
+			sales[row.BookNo] = make([]int32, days)
+		}
+		sales[row.BookNo][day] += row.BookCount
+	}
+	return sales, nil
+}
+
+// movingAverageDemand 返回最近 days 天的平均日销量
+func movingAverageDemand(daily []int32, days int) float64 {
+	var total int64
+	for _, count := range daily[:days] {
+		total += int64(count)
+	}
+	return float64(total) / float64(days)
+}
+
+// exponentialSmoothingDemand 从最早的一天开始对最近 days 天的日销量做指数平滑，初始值为这段时间的平均日销量
+func exponentialSmoothingDemand(daily []int32, days int, alpha float64) float64 {
+	level := movingAverageDemand(daily, days)
+	for day := days - 1; day >= 0; day-- {
+		level = alpha*float64(daily[day]) + (1-alpha)*level
+	}
+	return level
+}
+
+// suggestReorderQuantity 返回书籍在途的数量和建议的补货数量，
+// 建议数量是按 demand 支撑 coverDays 天需要的数量减去可以下单的库存和在途的数量，不需要补货时为 0
+func suggestReorderQuantity(tx *gorm.DB, book *models.Book, demand float64, coverDays int) (int32, int32, error) {
+	var requested, ordered int64
+	if err := tx.Model(&models.StockRequest{}).
+		Where("book_no = ? AND finished = ?", book.BookNo, false).
+		Select("COALESCE(SUM(quantity), 0)").Scan(&requested).Error; err != nil {
+		return 0, 0, err
+	}
+	if err := tx.Model(&models.PurchaseOrderItem{}).
+		Joins("JOIN purchase_orders ON purchase_orders.id = purchase_order_items.purchase_order_id").
+		Where("purchase_order_items.book_no = ? AND purchase_orders.finished = ?", book.BookNo, false).
+		Where("purchase_order_items.quantity > purchase_order_items.received_quantity").
+		Select("COALESCE(SUM(purchase_order_items.quantity - purchase_order_items.received_quantity), 0)").
+		Scan(&ordered).Error; err != nil {
+		return 0, 0, err
+	}
+	incoming := int32(requested + ordered)
+
+	// 减去很小的值，避免浮点误差让整数的需求多补一本
+	needed := int32(math.Ceil(demand*float64(coverDays) - 1e-9))
+	suggested := needed - book.AvailableQuantity() - incoming
+	if suggested < 0 {
+		suggested = 0
+	}
+	return incoming, suggested, nil
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestForecastDemand(t *testing.T) {
+	db := setupTestDB(t)
+	bookServer := NewBookServiceServer(db)
+	forecastServer := NewForecastServiceServer(db)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	ctx := context.Background()
+
+	for _, req := range []*pb.CreateBookRequest{
+		{BookNo: "B001", Title: "Book 1", PublisherName: "Publisher", Price: 10, StockQuantity: 10},
+		{BookNo: "B002", Title: "Book 2", PublisherName: "Publisher", Price: 10, StockQuantity: 100},
+		{BookNo: "B003", Title: "Book 3", PublisherName: "Publisher", Price: 10, StockQuantity: 5},
+	} {
+		_, err := bookServer.CreateBook(ctx, req)
+		assert.NoError(t, err)
+	}
+
+	// daysAgo 天前下单，订单时间放在那一天的中间
+	now := time.Now()
+	order := func(bookNo string, count int32, daysAgo int, orderStatus models.OrderStatus) {
+		db.Create(&models.CustomerOrder{
+			OrderDate: "2026-01-01",
+			Address:   "Address",
+			Status:    orderStatus,
+			Items:     []*models.CustomerOrderItem{{BookNo: bookNo, BookCount: count}},
+			CreatedAt: now.Add(-time.Duration(daysAgo)*24*time.Hour - time.Hour),
+		})
+	}
+	// B001 最近 28 天每天卖出 2 本，已取消的订单和 90 天之前的订单不计入销量
+	for day := 0; day < 28; day++ {
+		order("B001", 2, day, models.OrderStatusDelivered)
+	}
+	order("B001", 50, 0, models.OrderStatusCancelled)
+	order("B001", 50, 100, models.OrderStatusDelivered)
+	// B002 只在今天卖出 10 本
+	order("B002", 10, 0, models.OrderStatusPaid)
+
+	// 参数不合法
+	_, err := forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{SmoothingAlpha: 1.5})
+	assert.Equal(t, "smoothing_alpha", badRequestField(t, err))
+	_, err = forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{CoverDays: -1})
+	assert.Equal(t, "cover_days", badRequestField(t, err))
+	_, err = forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{BookNos: []string{"B404"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// 默认参数：移动平均 28 天，库存可以支撑 5 天，补足 30 天的需求需要 50 本
+	resp, err := forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Forecasts, 3)
+	b001 := resp.Forecasts[0]
+	assert.Equal(t, "B001", b001.BookNo)
+	assert.InDelta(t, 2, b001.MovingAverage, 1e-9)
+	assert.Less(t, b001.ExponentialSmoothing, 2.0)
+	assert.InDelta(t, 2, b001.DailyDemand, 1e-9)
+	assert.InDelta(t, 5, b001.GetDaysOfCover(), 1e-9)
+	assert.Equal(t, int32(50), b001.SuggestedQuantity)
+	assert.Equal(t, int32(0), b001.StockRequestId)
+	// 没有销量的书不需要补货
+	b003 := resp.Forecasts[2]
+	assert.Nil(t, b003.DaysOfCover)
+	assert.Equal(t, int32(0), b003.SuggestedQuantity)
+
+	// 指数平滑对最近的销量更敏感：初始值 5，平滑两天后为 6.25，支撑 30 天需要 188 本
+	resp, err = forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{BookNos: []string{"B002"}, SmoothingDays: 2, SmoothingAlpha: 0.5})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Forecasts, 1)
+	assert.InDelta(t, 10.0/28, resp.Forecasts[0].MovingAverage, 1e-9)
+	assert.InDelta(t, 6.25, resp.Forecasts[0].ExponentialSmoothing, 1e-9)
+	assert.InDelta(t, 6.25, resp.Forecasts[0].DailyDemand, 1e-9)
+	assert.InDelta(t, 16, resp.Forecasts[0].GetDaysOfCover(), 1e-9)
+	assert.Equal(t, int32(88), resp.Forecasts[0].SuggestedQuantity)
+
+	// 第二个缺书登记写入失败时所有书都不创建
+	failing := true
+	created := 0
+	db.Callback().Create().Before("gorm:create").Register("test:fail_stock_request", func(tx *gorm.DB) {
+		if failing && tx.Statement.Table == "stock_requests" {
+			if created++; created == 2 {
+				tx.AddError(errors.New("disk full"))
+			}
+		}
+	})
+	_, err = forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{SmoothingDays: 2, SmoothingAlpha: 0.5, CreateStockRequests: true})
+	assert.Error(t, err)
+	assert.Equal(t, 2, created)
+	var count int64
+	db.Model(&models.StockRequest{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+	failing = false
+
+	// 为建议补货的书创建缺书登记，再次预测时计入在途的数量，不重复创建
+	resp, err = forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{BookNos: []string{"B001", "B003"}, CreateStockRequests: true})
+	assert.NoError(t, err)
+	assert.NotZero(t, resp.Forecasts[0].StockRequestId)
+	assert.Equal(t, int32(0), resp.Forecasts[1].StockRequestId)
+	var stockRequest models.StockRequest
+	db.First(&stockRequest, resp.Forecasts[0].StockRequestId)
+	assert.Equal(t, "B001", stockRequest.BookNo)
+	assert.Equal(t, int32(50), stockRequest.Quantity)
+
+	resp, err = forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{BookNos: []string{"B001"}, CreateStockRequests: true})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(50), resp.Forecasts[0].IncomingQuantity)
+	assert.Equal(t, int32(0), resp.Forecasts[0].SuggestedQuantity)
+	assert.Equal(t, int32(0), resp.Forecasts[0].StockRequestId)
+
+	// 缺书登记生成采购单后，采购单中尚未到货的数量仍然算作在途
+	supplier := &models.Supplier{Name: "Supplier"}
+	db.Create(supplier)
+	db.Create(&models.SupplyBook{BookNo: "B001", Title: "Book 1", SupplierID: supplier.ID, Price: 5, Quantity: 100})
+	generateResp, err := purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(ctx, &pb.GeneratePurchaseOrdersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, generateResp.PurchaseOrders, 1)
+	assert.Equal(t, int32(50), generateResp.PurchaseOrders[0].Quantity)
+	resp, err = forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{BookNos: []string{"B001"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(50), resp.Forecasts[0].IncomingQuantity)
+	assert.Equal(t, int32(0), resp.Forecasts[0].SuggestedQuantity)
+
+	// 被预留的库存不能再卖，与补货点一样按可以下单的数量计算
+	db.Model(&models.Book{}).Where("book_no = ?", "B001").Update("reserved_quantity", 4)
+	resp, err = forecastServer.ForecastDemand(ctx, &pb.ForecastDemandRequest{BookNos: []string{"B001"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), resp.Forecasts[0].StockQuantity)
+	assert.Equal(t, int32(6), resp.Forecasts[0].AvailableQuantity)
+	assert.InDelta(t, 3, resp.Forecasts[0].GetDaysOfCover(), 1e-9)
+	assert.Equal(t, int32(4), resp.Forecasts[0].SuggestedQuantity)
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/notification"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// notifyCustomer 按客户的通知偏好通过各个渠道发送 event 通知，跳过客户没有填写联系方式的渠道，返回写入发件箱的通知数
+func notifyCustomer(tx *gorm.DB, customer *models.Customer, event string, data interface{}) (int, error) {
+	recipient := notification.Recipient{OnlineID: customer.OnlineID, Email: customer.Email, Phone: customer.Phone}
+	count := 0
+	for _, channel := range customer.NotificationChannels(event) {
+		if channelAddress(channel, customer.Email, customer.Phone) == "" {
+			continue
+		}
+		if err := notification.Enqueue(tx, event, channel, recipient, data); err != nil {
+			return count, status.Errorf(codes.Internal, "Failed to enqueue notification: %v", err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// channelAddress 返回渠道对应的联系方式
+func channelAddress(channel, email, phone string) string {
+	switch channel {
+	case models.NotificationChannelEmail:
+		return email
+	case models.NotificationChannelSMS:
+		return phone
+	default:
+		return ""
+	}
+}
+
+// 通知渠道和 protobuf 枚举的对应关系
+var notificationChannels = map[pb.NotificationChannel]string{
+	pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL: models.NotificationChannelEmail,
+	pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS:   models.NotificationChannelSMS,
+}
+
+// fromPbNotificationPreferences 验证并转换请求中的通知偏好，选择的渠道需要客户有对应的联系方式
+func fromPbNotificationPreferences(preferences *pb.NotificationPreferences, email, phone string) (*models.NotificationPreferences, error) {
+	result := &models.NotificationPreferences{Channels: []string{}}
+	seen := make(map[string]bool)
+	for _, pbChannel := range preferences.GetChannels() {
+		channel, ok := notificationChannels[pbChannel]
+		if !ok {
+			return nil, invalidArgumentError("notification_preferences.channels", fmt.Sprintf("Unknown notification channel: %v", pbChannel))
+		}
+		if seen[channel] {
+			continue
+		}
+		if channelAddress(channel, email, phone) == "" {
+			return nil, invalidArgumentError("notification_preferences.channels", fmt.Sprintf("Channel %s requires a contact address", channel))
+		}
+		seen[channel] = true
+		result.Channels = append(result.Channels, channel)
+	}
+
+	for _, event := range preferences.GetEvents() {
+		if !notification.KnownEvent(event.GetEvent()) {
+			return nil, invalidArgumentError("notification_preferences.events", fmt.Sprintf("Unknown notification event: %q", event.GetEvent()))
+		}
+		if result.Events == nil {
+			result.Events = make(map[string]bool)
+		}
+		result.Events[event.GetEvent()] = event.GetEnabled()
+	}
+	return result, nil
+}
+
+// toPbNotificationPreferences 把通知偏好转换为 protobuf 消息，未设置时返回默认偏好
+func toPbNotificationPreferences(preferences *models.NotificationPreferences) *pb.NotificationPreferences {
+	if preferences == nil {
+		preferences = &models.NotificationPreferences{Channels: []string{models.NotificationChannelEmail}}
+	}
+	result := &pb.NotificationPreferences{}
+	for _, channel := range preferences.Channels {
+		for pbChannel, value := range notificationChannels {
+			if value == channel {
+				result.Channels = append(result.Channels, pbChannel)
+			}
+		}
+	}
+	for event, enabled := range preferences.Events {
+		result.Events = append(result.Events, &pb.NotificationEventPreference{Event: event, Enabled: enabled})
+	}
+	sort.Slice(result.Events, func(i, j int) bool {
+		return result.Events[i].Event < result.Events[j].Event
+	})
+	return result
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyCustomerPreferences(t *testing.T) {
+	db := setupTestDB(t)
+
+	// 默认偏好、关闭上新通知、同时使用邮件和短信、没有联系方式的客户
+	customers := []models.Customer{
+		{OnlineID: "default", Name: "Default", Address: "Address", Email: "default@example.com"},
+		{OnlineID: "opted_out", Name: "Opted Out", Address: "Address", Email: "opted_out@example.com", NotificationPreferences: &models.NotificationPreferences{
+			Channels: []string{models.NotificationChannelEmail},
+			Events:   map[string]bool{notification.EventBookAvailable: false},
+		}},
+		{OnlineID: "both", Name: "Both", Address: "Address", Email: "both@example.com", Phone: "13800000000", NotificationPreferences: &models.NotificationPreferences{
+			Channels: []string{models.NotificationChannelEmail, models.NotificationChannelSMS},
+		}},
+		{OnlineID: "no_contact", Name: "No Contact", Address: "Address"},
+	}
+	notified := 0
+	for i := range customers {
+		db.Create(&customers[i])
+		data := notification.BookAvailableData{CustomerName: customers[i].Name, BookNo: "B001", Title: "Book 1"}
+		count, err := notifyCustomer(db, &customers[i], notification.EventBookAvailable, data)
+		assert.NoError(t, err)
+		notified += count
+	}
+	assert.Equal(t, 3, notified)
+
+	var messages []models.OutboxMessage
+	db.Order("id").Find(&messages)
+	var sent []string
+	for _, message := range messages {
+		sent = append(sent, message.Recipient+"/"+message.Channel)
+	}
+	assert.Equal(t, []string{"default/email", "both/email", "both/sms"}, sent)
+	assert.Equal(t, "13800000000", messages[2].Phone)
+
+	// 选择的渠道没有联系方式时不写入发件箱
+	db.Where("1 = 1").Delete(&models.OutboxMessage{})
+	customers[2].Email = ""
+	customers[2].Phone = ""
+	count, err := notifyCustomer(db, &customers[2], notification.EventBookAvailable, notification.BookAvailableData{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+	var total int64
+	db.Model(&models.OutboxMessage{}).Count(&total)
+	assert.Equal(t, int64(0), total)
+}
@@ -9,6 +9,8 @@ import (
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
 	"github.com/agnivade/levenshtein"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
@@ -38,7 +40,7 @@ func (s *OnlineServiceServer) QueryCustomer(ctx context.Context, req *pb.QueryCu
 	if input != "" {
 		// 尝试匹配 online_id
 		var onlineIDCustomers []models.Customer
-		if err := s.db.Preload("CustomerOrders").Where("online_id LIKE ?", "%"+input+"%").Find(&onlineIDCustomers).Error; err == nil && len(onlineIDCustomers) > 0 {
+		if err := s.db.Preload("CustomerOrders.Items").Scopes(containsText("online_id", input)).Find(&onlineIDCustomers).Error; err == nil && len(onlineIDCustomers) > 0 {
 			for _, customer := range onlineIDCustomers {
 				customerMap[customer.ID] = customer
 				feedbackMap[customer.ID] = "Matched by online_id"
@@ -47,7 +49,7 @@ func (s *OnlineServiceServer) QueryCustomer(ctx context.Context, req *pb.QueryCu
 
 		// 尝试匹配 name
 		var nameCustomers []models.Customer
-		if err := s.db.Preload("CustomerOrders").Where("name LIKE ?", "%"+input+"%").Find(&nameCustomers).Error; err == nil && len(nameCustomers) > 0 {
+		if err := s.db.Preload("CustomerOrders.Items").Scopes(containsText("name", input)).Find(&nameCustomers).Error; err == nil && len(nameCustomers) > 0 {
 			for _, customer := range nameCustomers {
 				customerMap[customer.ID] = customer
 				feedbackMap[customer.ID] = "Matched by name"
@@ -56,7 +58,7 @@ func (s *OnlineServiceServer) QueryCustomer(ctx context.Context, req *pb.QueryCu
 
 		// 尝试匹配 address
 		var addressCustomers []models.Customer
-		if err := s.db.Preload("CustomerOrders").Where("address LIKE ?", "%"+input+"%").Find(&addressCustomers).Error; err == nil && len(addressCustomers) > 0 {
+		if err := s.db.Preload("CustomerOrders.Items").Scopes(containsText("address", input)).Find(&addressCustomers).Error; err == nil && len(addressCustomers) > 0 {
 			for _, customer := range addressCustomers {
 				customerMap[customer.ID] = customer
 				feedbackMap[customer.ID] = "Matched by address"
@@ -68,7 +70,7 @@ func (s *OnlineServiceServer) QueryCustomer(ctx context.Context, req *pb.QueryCu
 			var customerOrder models.CustomerOrder
 			if err := s.db.First(&customerOrder, orderId).Error; err == nil {
 				var customer models.Customer
-				if err := s.db.Preload("CustomerOrders").Where("online_id = ?", customerOrder.CustomerOnlineID).First(&customer).Error; err == nil {
+				if err := s.db.Preload("CustomerOrders.Items").Where("online_id = ?", customerOrder.CustomerOnlineID).First(&customer).Error; err == nil {
 					customerMap[customer.ID] = customer
 					feedbackMap[customer.ID] = "Matched by CustomerOrder ID"
 				}
@@ -77,10 +79,7 @@ func (s *OnlineServiceServer) QueryCustomer(ctx context.Context, req *pb.QueryCu
 	}
 
 	if len(customerMap) == 0 {
-		return &pb.QueryCustomerResponse{
-			Success:  false,
-			Feedback: "No customers found",
-		}, nil
+		return nil, status.Error(codes.NotFound, "No customers found")
 	}
 
 	for _, customer := range customerMap {
@@ -91,18 +90,9 @@ func (s *OnlineServiceServer) QueryCustomer(ctx context.Context, req *pb.QueryCu
 	for _, customer := range customers {
 		var pbOrders []*pb.CustomerOrder
 		for _, order := range customer.CustomerOrders {
-			pbOrder := &pb.CustomerOrder{
-				Id:               order.ID,
-				OrderDate:        order.OrderDate,
-				CustomerOnlineId: order.CustomerOnlineID,
-				BookNo:           order.BookNo,
-				BookCount:        order.BookCount,
-				Price:            order.Price,
-				Address:          order.Address,
-				Status:           order.Status,
-				CreatedAt:        timestamppb.New(order.CreatedAt),
-				UpdatedAt:        timestamppb.New(order.UpdatedAt),
-			}
+			pbOrder := toPbCustomerOrder(order)
+			pbOrder.CreatedAt = timestamppb.New(order.CreatedAt)
+			pbOrder.UpdatedAt = timestamppb.New(order.UpdatedAt)
 			pbOrders = append(pbOrders, pbOrder)
 		}
 		pbCustomer := &pb.Customer{
@@ -132,7 +122,7 @@ func (s *OnlineServiceServer) QueryBook(ctx context.Context, req *pb.QueryBookRe
 	if input != "" {
 		// 尝试匹配 book_no
 		var bookNoBooks []models.Book
-		if err := s.db.Where("book_no LIKE ?", "%"+input+"%").Find(&bookNoBooks).Error; err == nil && len(bookNoBooks) > 0 {
+		if err := s.db.Scopes(containsText("book_no", input)).Find(&bookNoBooks).Error; err == nil && len(bookNoBooks) > 0 {
 			for _, book := range bookNoBooks {
 				if matchScore := getMatchScore(input, book.BookNo); matchScore >= float64(s.matchThreshold) {
 					bookMap[book.ID] = book
@@ -144,7 +134,7 @@ func (s *OnlineServiceServer) QueryBook(ctx context.Context, req *pb.QueryBookRe
 
 		// 尝试匹配 title
 		var titleBooks []models.Book
-		if err := s.db.Where("title LIKE ?", "%"+input+"%").Find(&titleBooks).Error; err == nil && len(titleBooks) > 0 {
+		if err := s.db.Scopes(containsText("title", input)).Find(&titleBooks).Error; err == nil && len(titleBooks) > 0 {
 			for _, book := range titleBooks {
 				if matchScore := getMatchScore(input, book.Title); matchScore >= float64(s.matchThreshold) {
 					bookMap[book.ID] = book
@@ -156,7 +146,7 @@ func (s *OnlineServiceServer) QueryBook(ctx context.Context, req *pb.QueryBookRe
 
 		// 尝试匹配 publisher_name
 		var publisherNameBooks []models.Book
-		if err := s.db.Where("publisher_name LIKE ?", "%"+input+"%").Find(&publisherNameBooks).Error; err == nil && len(publisherNameBooks) > 0 {
+		if err := s.db.Scopes(containsText("publisher_name", input)).Find(&publisherNameBooks).Error; err == nil && len(publisherNameBooks) > 0 {
 			for _, book := range publisherNameBooks {
 				if matchScore := getMatchScore(input, book.PublisherName); matchScore >= float64(s.matchThreshold) {
 					bookMap[book.ID] = book
@@ -168,7 +158,7 @@ func (s *OnlineServiceServer) QueryBook(ctx context.Context, req *pb.QueryBookRe
 
 		// 尝试匹配 keywords
 		var keywordsBooks []models.Book
-		if err := s.db.Where("keywords LIKE ?", "%"+input+"%").Find(&keywordsBooks).Error; err == nil && len(keywordsBooks) > 0 {
+		if err := s.db.Scopes(containsText("keywords", input)).Find(&keywordsBooks).Error; err == nil && len(keywordsBooks) > 0 {
 			for _, book := range keywordsBooks {
 				if matchScore := getKeywordsMatchScore(input, book.Keywords, s); matchScore >= float64(s.matchThreshold) {
 					bookMap[book.ID] = book
@@ -180,7 +170,7 @@ func (s *OnlineServiceServer) QueryBook(ctx context.Context, req *pb.QueryBookRe
 
 		// 尝试匹配 authors
 		var authorsBooks []models.Book
-		if err := s.db.Where("authors LIKE ?", "%"+input+"%").Find(&authorsBooks).Error; err == nil && len(authorsBooks) > 0 {
+		if err := s.db.Scopes(containsText("authors", input)).Find(&authorsBooks).Error; err == nil && len(authorsBooks) > 0 {
 			for _, book := range authorsBooks {
 				if matchScore := getAuthorsMatchScore(input, book.Authors, s); matchScore >= float64(s.matchThreshold) {
 					bookMap[book.ID] = book
@@ -192,10 +182,7 @@ func (s *OnlineServiceServer) QueryBook(ctx context.Context, req *pb.QueryBookRe
 	}
 
 	if len(bookMap) == 0 {
-		return &pb.QueryBookResponse{
-			Success:  false,
-			Feedback: "No books found",
-		}, nil
+		return nil, status.Error(codes.NotFound, "No books found")
 	}
 
 	for _, book := range bookMap {
@@ -205,16 +192,18 @@ func (s *OnlineServiceServer) QueryBook(ctx context.Context, req *pb.QueryBookRe
 	var pbBooks []*pb.Book
 	for _, book := range books {
 		pbBook := &pb.Book{
-			Id:            book.ID,
-			BookNo:        book.BookNo,
-			Title:         fmt.Sprintf("%s (%s, %.2f%%)", book.Title, feedbackMap[book.ID], matchScoreMap[book.ID]),
-			PublisherName: book.PublisherName,
-			Price:         book.Price,
-			Keywords:      book.Keywords,
-			Authors:       book.Authors,
-			StockQuantity: book.StockQuantity,
-			CreatedAt:     timestamppb.New(book.CreatedAt),
-			UpdatedAt:     timestamppb.New(book.UpdatedAt),
+			Id:                book.ID,
+			BookNo:            book.BookNo,
+			Title:             fmt.Sprintf("%s (%s, %.2f%%)", book.Title, feedbackMap[book.ID], matchScoreMap[book.ID]),
+			PublisherName:     book.PublisherName,
+			Price:             book.Price,
+			Keywords:          book.Keywords,
+			Authors:           book.Authors,
+			StockQuantity:     book.StockQuantity,
+			ReservedQuantity:  book.ReservedQuantity,
+			AvailableQuantity: book.AvailableQuantity(),
+			CreatedAt:         timestamppb.New(book.CreatedAt),
+			UpdatedAt:         timestamppb.New(book.UpdatedAt),
 		}
 		pbBooks = append(pbBooks, pbBook)
 	}
@@ -286,3 +275,14 @@ func max(a, b int) int {
 	}
 	return b
 }
+
+// containsText 匹配包含输入文本的记录，忽略大小写并转义通配符，在 MySQL、PostgreSQL 和 SQLite 上行为一致
+func containsText(column, input string) func(*gorm.DB) *gorm.DB {
+	pattern := "%" + likeEscaper.Replace(strings.ToLower(input)) + "%"
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("LOWER("+column+") LIKE ? ESCAPE '!'", pattern)
+	}
+}
+
+// likeEscaper 转义 LIKE 中的通配符，转义字符为 !
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
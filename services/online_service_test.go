@@ -7,23 +7,12 @@ import (
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func setupTestDBQuery(t *testing.T) *gorm.DB {
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err != nil {
-		t.Fatalf("failed to open database: %v", err)
-	}
-	if err := db.AutoMigrate(&models.Book{}, &models.Customer{}, &models.CustomerOrder{}); err != nil {
-		t.Fatalf("failed to migrate database: %v", err)
-	}
-	return db
-}
-
 func TestQueryBook(t *testing.T) {
-	db := setupTestDBQuery(t)
+	db := setupTestDB(t)
 	server := NewOnlineServiceServer(db, 50)
 
 	// 添加书籍
@@ -52,18 +41,18 @@ func TestQueryBook(t *testing.T) {
 		req := &pb.QueryBookRequest{Input: test.input}
 		resp, err := server.QueryBook(context.Background(), req)
 		t.Logf("QueryBook(%s) = %v", test.input, resp)
-		assert.NoError(t, err)
-		assert.Equal(t, test.expected, len(resp.Books))
+		assert.Equal(t, test.expected, len(resp.GetBooks()))
 		if test.expected > 0 {
+			assert.NoError(t, err)
 			assert.True(t, resp.Success)
 		} else {
-			assert.False(t, resp.Success)
+			assert.Equal(t, codes.NotFound, status.Code(err))
 		}
 	}
 }
 
 func TestQueryCustomer(t *testing.T) {
-	db := setupTestDBQuery(t)
+	db := setupTestDB(t)
 	server := NewOnlineServiceServer(db, 50)
 
 	// 添加客户
@@ -78,9 +67,9 @@ func TestQueryCustomer(t *testing.T) {
 
 	// 添加订单
 	orders := []models.CustomerOrder{
-		{CustomerOnlineID: "C001", BookNo: "B001", BookCount: 1, Price: 100, Address: "123 Wonderland", Status: "Shipped"},
-		{CustomerOnlineID: "C002", BookNo: "B002", BookCount: 2, Price: 200, Address: "456 Nowhere", Status: "Pending"},
-		{CustomerOnlineID: "C003", BookNo: "B003", BookCount: 3, Price: 300, Address: "789 Everywhere", Status: "Delivered"},
+		{CustomerOnlineID: "C001", Price: 100, Address: "123 Wonderland", Status: "Shipped", Items: []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 1, Price: 100}}},
+		{CustomerOnlineID: "C002", Price: 200, Address: "456 Nowhere", Status: "Pending", Items: []*models.CustomerOrderItem{{BookNo: "B002", BookCount: 2, Price: 200}}},
+		{CustomerOnlineID: "C003", Price: 300, Address: "789 Everywhere", Status: "Delivered", Items: []*models.CustomerOrderItem{{BookNo: "B003", BookCount: 3, Price: 300}}},
 	}
 	for _, order := range orders {
 		db.Create(&order)
@@ -100,20 +89,24 @@ func TestQueryCustomer(t *testing.T) {
 		{"C003", 1, 1},
 		{"Charlie", 1, 1},
 		{"789 Everywhere", 1, 1},
+		// 匹配忽略大小写，通配符按普通字符匹配
+		{"alice", 1, 1},
+		{"%", 0, 0},
+		{"_", 0, 0},
 		{"Nonexistent", 0, 0},
 	}
 
 	for _, test := range tests {
 		req := &pb.QueryCustomerRequest{Input: test.input}
 		resp, err := server.QueryCustomer(context.Background(), req)
-		t.Logf("QueryCustomer(%s) = %v", test.input, resp.Customers)
-		assert.NoError(t, err)
-		assert.Equal(t, test.expected, len(resp.Customers))
+		t.Logf("QueryCustomer(%s) = %v", test.input, resp.GetCustomers())
+		assert.Equal(t, test.expected, len(resp.GetCustomers()))
 		if test.expected > 0 {
+			assert.NoError(t, err)
 			assert.True(t, resp.Success)
 			assert.Equal(t, test.expectedOrders, len(resp.Customers[0].CustomerOrders))
 		} else {
-			assert.False(t, resp.Success)
+			assert.Equal(t, codes.NotFound, status.Code(err))
 		}
 	}
 }
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// 允许的订单状态变更，已取消和已退货的订单不能再变更。
+// 缺货订单只能在缺货的书到货后由系统变更为 ready，见 reserveBackorder。
+var orderStatusTransitions = map[models.OrderStatus][]models.OrderStatus{
+	models.OrderStatusPending:     {models.OrderStatusPaid, models.OrderStatusCancelled},
+	models.OrderStatusPaid:        {models.OrderStatusShipped, models.OrderStatusCancelled},
+	models.OrderStatusBackordered: {models.OrderStatusReady, models.OrderStatusCancelled},
+	models.OrderStatusReady:       {models.OrderStatusShipped, models.OrderStatusCancelled},
+	models.OrderStatusShipped:     {models.OrderStatusDelivered, models.OrderStatusReturned},
+	models.OrderStatusDelivered:   {models.OrderStatusReturned},
+}
+
+// 订单状态与 protobuf 枚举的对应关系
+var pbOrderStatuses = map[models.OrderStatus]pb.OrderStatus{
+	models.OrderStatusPending:     pb.OrderStatus_ORDER_STATUS_PENDING,
+	models.OrderStatusPaid:        pb.OrderStatus_ORDER_STATUS_PAID,
+	models.OrderStatusShipped:     pb.OrderStatus_ORDER_STATUS_SHIPPED,
+	models.OrderStatusDelivered:   pb.OrderStatus_ORDER_STATUS_DELIVERED,
+	models.OrderStatusCancelled:   pb.OrderStatus_ORDER_STATUS_CANCELLED,
+	models.OrderStatusReturned:    pb.OrderStatus_ORDER_STATUS_RETURNED,
+	models.OrderStatusBackordered: pb.OrderStatus_ORDER_STATUS_BACKORDERED,
+	models.OrderStatusReady:       pb.OrderStatus_ORDER_STATUS_READY,
+}
+
+// toPbOrderStatus 把数据库中的订单状态转换为 protobuf 枚举，尚未迁移的旧状态也能识别
+func toPbOrderStatus(value models.OrderStatus) pb.OrderStatus {
+	orderStatus, ok := models.ParseOrderStatus(string(value))
+	if !ok {
+		return pb.OrderStatus_ORDER_STATUS_UNSPECIFIED
+	}
+	return pbOrderStatuses[orderStatus]
+}
+
+// requestedOrderStatus 解析请求中的目标状态，优先使用枚举字段，其次使用旧的字符串字段，都没有填写时返回空
+func requestedOrderStatus(orderStatus pb.OrderStatus, value string) (models.OrderStatus, error) {
+	if orderStatus != pb.OrderStatus_ORDER_STATUS_UNSPECIFIED {
+		for modelStatus, pbStatus := range pbOrderStatuses {
+			if pbStatus == orderStatus {
+				return modelStatus, nil
+			}
+		}
+		return "", invalidArgumentError("order_status", fmt.Sprintf("Unknown order status: %v", orderStatus))
+	}
+	if value == "" {
+		return "", nil
+	}
+	modelStatus, ok := models.ParseOrderStatus(value)
+	if !ok {
+		return "", invalidArgumentError("status", fmt.Sprintf("Unknown order status: %s", value))
+	}
+	return modelStatus, nil
+}
+
+// canTransitionOrderStatus 判断订单状态能否从 from 变更为 to
+func canTransitionOrderStatus(from, to models.OrderStatus) bool {
+	for _, next := range orderStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// transitionOrderStatus 在事务中变更订单状态并记录变更时间，不允许的变更返回 FailedPrecondition
+func transitionOrderStatus(tx *gorm.DB, order *models.CustomerOrder, to models.OrderStatus) error {
+	from, _ := models.ParseOrderStatus(string(order.Status))
+	if !canTransitionOrderStatus(from, to) {
+		return status.Errorf(codes.FailedPrecondition, "Cannot change order status from %s to %s", from, to)
+	}
+
+	// 带条件更新，防止并发修改时基于过期的状态做判断
+	now := time.Now()
+	result := tx.Model(&models.CustomerOrder{}).
+		Where("id = ? AND status = ?", order.ID, order.Status).
+		Updates(map[string]interface{}{"status": to, "updated_at": now})
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "Failed to update order status: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return status.Errorf(codes.FailedPrecondition, "Order status was changed by another request")
+	}
+
+	// 记录状态变更
+	transition := &models.CustomerOrderStatusTransition{
+		OrderID:    order.ID,
+		FromStatus: from,
+		ToStatus:   to,
+		CreatedAt:  now,
+	}
+	if err := tx.Create(transition).Error; err != nil {
+		return status.Errorf(codes.Internal, "Failed to record order status change: %v", err)
+	}
+
+	order.Status = to
+	order.UpdatedAt = now
+	return nil
+}
+
+// toPbStatusHistory 把状态变更记录转换为 protobuf 消息
+func toPbStatusHistory(transitions []*models.CustomerOrderStatusTransition) []*pb.OrderStatusTransition {
+	var pbTransitions []*pb.OrderStatusTransition
+	for _, transition := range transitions {
+		pbTransitions = append(pbTransitions, &pb.OrderStatusTransition{
+			FromStatus: toPbOrderStatus(transition.FromStatus),
+			ToStatus:   toPbOrderStatus(transition.ToStatus),
+			ChangedAt:  timestamppb.New(transition.CreatedAt),
+		})
+	}
+	return pbTransitions
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// 游标分页的默认和最大每页条数
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// pageTokenSecret 是分页游标的签名密钥，启动时由 SetPageTokenSecret 设置，未设置时使用随机密钥
+var pageTokenSecret = randomPageTokenSecret()
+
+// SetPageTokenSecret 设置分页游标的签名密钥，多个实例共用同一个密钥时游标可以在实例之间通用
+func SetPageTokenSecret(secret []byte) {
+	pageTokenSecret = secret
+}
+
+func randomPageTokenSecret() []byte {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return secret
+}
+
+// pageRequest 是列表请求中的分页参数
+type pageRequest interface {
+	GetStart() int32
+	GetStop() int32
+	GetPageSize() int32
+	GetPageToken() string
+}
+
+// pageCursor 是分页游标的内容，指向上一页最后一条记录，Scope 绑定查询和过滤条件，游标不能用在其他查询上
+type pageCursor struct {
+	CreatedAt int64  `json:"t"`
+	ID        int32  `json:"i"`
+	Scope     string `json:"s"`
+}
+
+// page 是一页查询结果
+type page[T any] struct {
+	Items         []*T
+	NextPageToken string
+	TotalCount    int32
+}
+
+// paginate 查询一页记录并统计符合条件的总数，记录按 (created_at, id) 升序排列。
+// 请求设置了 page_size 或 page_token 时使用游标分页，否则按 start 和 stop 分页以兼容旧客户端。
+// scope 是查询的名称和过滤条件，key 返回记录的创建时间和 ID。
+func paginate[T any](query *gorm.DB, req pageRequest, scope string, key func(*T) (time.Time, int32)) (*page[T], error) {
+	query = query.Session(&gorm.Session{})
+	result := &page[T]{}
+
+	// 统计总数
+	var total int64
+	if err := query.Model(new(T)).Count(&total).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to count records: %v", err)
+	}
+	result.TotalCount = int32(total)
+	ordered := query.Order("created_at").Order("id")
+
+	// 兼容旧的 start 和 stop 分页
+	if req.GetPageSize() == 0 && req.GetPageToken() == "" {
+		if err := validateRange(req.GetStart(), req.GetStop()); err != nil {
+			return nil, err
+		}
+		if err := ordered.Offset(int(req.GetStart())).Limit(int(req.GetStop() - req.GetStart() + 1)).Find(&result.Items).Error; err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to query records: %v", err)
+		}
+		return result, nil
+	}
+
+	// 验证分页参数
+	pageSize := int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, invalidArgumentError("page_size", "Page size must be >= 0")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	scopeHash := hashPageScope(scope)
+	if req.GetPageToken() != "" {
+		cursor, err := decodePageToken(req.GetPageToken())
+		if err != nil || cursor.Scope != scopeHash {
+			return nil, invalidArgumentError("page_token", "Invalid page token")
+		}
+		createdAt := time.Unix(0, cursor.CreatedAt)
+		ordered = ordered.Where("created_at > ? OR (created_at = ? AND id > ?)", createdAt, createdAt, cursor.ID)
+	}
+
+	// 多查询一条用于判断是否还有下一页
+	if err := ordered.Limit(pageSize + 1).Find(&result.Items).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to query records: %v", err)
+	}
+	if len(result.Items) > pageSize {
+		result.Items = result.Items[:pageSize]
+		createdAt, id := key(result.Items[pageSize-1])
+		token, err := encodePageToken(pageCursor{CreatedAt: createdAt.UnixNano(), ID: id, Scope: scopeHash})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to encode page token: %v", err)
+		}
+		result.NextPageToken = token
+	}
+	return result, nil
+}
+
+// pageScope 把查询名称和过滤条件拼接为游标的 scope
+func pageScope(name string, filters ...interface{}) string {
+	parts := []string{name}
+	for _, filter := range filters {
+		// 指针类型的过滤条件按值拼接，未设置时为空
+		switch value := filter.(type) {
+		case *timestamppb.Timestamp:
+			filter = ""
+			if value != nil {
+				filter = value.AsTime().UnixNano()
+			}
+		case *bool:
+			filter = ""
+			if value != nil {
+				filter = *value
+			}
+		}
+		parts = append(parts, fmt.Sprint(filter))
+	}
+	return strings.Join(parts, "|")
+}
+
+// hashPageScope 计算 scope 的摘要，避免游标中暴露过滤条件
+func hashPageScope(scope string) string {
+	sum := sha256.Sum256([]byte(scope))
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// encodePageToken 把游标编码为 base64(cursor).base64(signature)
+func encodePageToken(cursor pageCursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + pageTokenSignature(encoded), nil
+}
+
+// decodePageToken 验证游标的签名并解码
+func decodePageToken(token string) (*pageCursor, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(pageTokenSignature(encoded))) {
+		return nil, errInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, errInvalidToken
+	}
+	return &cursor, nil
+}
+
+// pageTokenSignature 计算游标内容的签名
+func pageTokenSignature(encoded string) string {
+	mac := hmac.New(sha256.New, pageTokenSecret)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// createdBetween 按创建时间过滤，包含 after，不包含 before，为空的一端不限制
+func createdBetween(after, before *timestamppb.Timestamp) (func(*gorm.DB) *gorm.DB, error) {
+	if after != nil && before != nil && !after.AsTime().Before(before.AsTime()) {
+		return nil, invalidArgumentError("created_before", "created_before must be after created_after")
+	}
+	return func(db *gorm.DB) *gorm.DB {
+		if after != nil {
+			db = db.Where("created_at >= ?", after.AsTime().Local())
+		}
+		if before != nil {
+			db = db.Where("created_at < ?", before.AsTime().Local())
+		}
+		return db
+	}, nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPurchaseOrderPagination(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewPurchaseOrderServiceServer(db)
+
+	// 添加 7 个采购单，前 5 个来自 Supplier A，其中偶数的已完成
+	base := time.Now().Add(-time.Hour)
+	for i := 0; i < 7; i++ {
+		supplier := "Supplier A"
+		if i >= 5 {
+			supplier = "Supplier B"
+		}
+		db.Create(&models.PurchaseOrder{
+			Supplier: supplier,
+			Items: []*models.PurchaseOrderItem{{
+				BookNo: fmt.Sprintf("B%03d", i), Title: "Title", Publisher: "Publisher", Author: "Author", Quantity: 1,
+			}},
+			OrderDate: "2023-01-01",
+			Finished:  i%2 == 0,
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		})
+	}
+
+	// 按页遍历，每页 3 条
+	var ids []int32
+	req := &pb.GetPurchaseOrderRequest{PageSize: 3}
+	for {
+		resp, err := server.GetPurchaseOrder(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(7), resp.TotalCount)
+		for _, order := range resp.PurchaseOrders {
+			ids = append(ids, order.Id)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.Equal(t, []int32{1, 2, 3, 4, 5, 6, 7}, ids)
+
+	// 翻页时插入的记录不会导致重复或遗漏
+	resp, err := server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageSize: 4})
+	assert.NoError(t, err)
+	db.Create(&models.PurchaseOrder{Supplier: "Supplier B", OrderDate: "2023-01-02", Items: []*models.PurchaseOrderItem{{BookNo: "B100", Title: "Title", Publisher: "Publisher", Author: "Author", Quantity: 1}}})
+	resp, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageSize: 4, PageToken: resp.NextPageToken})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(resp.PurchaseOrders))
+	assert.Equal(t, int32(5), resp.PurchaseOrders[0].Id)
+	assert.Equal(t, "B100", resp.PurchaseOrders[3].BookNo)
+
+	// 过滤条件
+	finished := true
+	resp, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageSize: 10, Supplier: "Supplier A", Finished: &finished})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.TotalCount)
+	for _, order := range resp.PurchaseOrders {
+		assert.Equal(t, "Supplier A", order.Supplier)
+		assert.True(t, order.Finished)
+	}
+	notFinished := false
+	resp, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageSize: 10, Supplier: "Supplier A", Finished: &notFinished})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.TotalCount)
+	resp, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageSize: 10, BookNo: "B003"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.TotalCount)
+	resp, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{
+		PageSize:      10,
+		CreatedAfter:  timestamppb.New(base.Add(2 * time.Minute)),
+		CreatedBefore: timestamppb.New(base.Add(4 * time.Minute)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.TotalCount)
+
+	// 游标不能用在过滤条件不同的查询上
+	resp, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageSize: 1, Supplier: "Supplier A"})
+	assert.NoError(t, err)
+	_, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageSize: 1, Supplier: "Supplier B", PageToken: resp.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 篡改过的游标和错误的参数
+	_, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageToken: "x" + resp.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{PageSize: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{
+		PageSize:      10,
+		CreatedAfter:  timestamppb.New(base),
+		CreatedBefore: timestamppb.New(base),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 旧的 start 和 stop 分页仍然可用
+	resp, err = server.GetPurchaseOrder(context.Background(), &pb.GetPurchaseOrderRequest{Start: 1, Stop: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{2, 3}, []int32{resp.PurchaseOrders[0].Id, resp.PurchaseOrders[1].Id})
+	assert.Equal(t, "", resp.NextPageToken)
+}
+
+func TestCustomerOrderFilters(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewCustomerOrderServiceServer(db)
+
+	orders := []models.CustomerOrder{
+		{OrderDate: "2023-01-01", CustomerOnlineID: "customer1", Address: "Address 1", Status: models.OrderStatusPaid, Items: []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 1}}},
+		{OrderDate: "2023-01-02", CustomerOnlineID: "customer1", Address: "Address 1", Status: models.OrderStatusShipped, Items: []*models.CustomerOrderItem{{BookNo: "B002", BookCount: 1}}},
+		{OrderDate: "2023-01-03", CustomerOnlineID: "customer2", Address: "Address 2", Status: models.OrderStatusPaid, Items: []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 1}, {BookNo: "B003", BookCount: 2}}},
+	}
+	for i := range orders {
+		db.Create(&orders[i])
+	}
+
+	tests := []struct {
+		name     string
+		req      *pb.GetCustomerOrderRequest
+		expected []int32
+	}{
+		{"status", &pb.GetCustomerOrderRequest{PageSize: 10, OrderStatus: pb.OrderStatus_ORDER_STATUS_PAID}, []int32{orders[0].ID, orders[2].ID}},
+		{"customer", &pb.GetCustomerOrderRequest{PageSize: 10, CustomerOnlineId: "customer1"}, []int32{orders[0].ID, orders[1].ID}},
+		{"book_no", &pb.GetCustomerOrderRequest{PageSize: 10, BookNo: "B001"}, []int32{orders[0].ID, orders[2].ID}},
+		{"combined", &pb.GetCustomerOrderRequest{PageSize: 10, CustomerOnlineId: "customer2", BookNo: "B003"}, []int32{orders[2].ID}},
+	}
+	for _, test := range tests {
+		resp, err := server.GetCustomerOrder(context.Background(), test.req)
+		assert.NoError(t, err, test.name)
+		var ids []int32
+		for _, order := range resp.CustomerOrders {
+			ids = append(ids, order.Id)
+		}
+		assert.Equal(t, test.expected, ids, test.name)
+		assert.Equal(t, int32(len(test.expected)), resp.TotalCount, test.name)
+	}
+
+	// 多行订单的明细完整返回
+	resp, err := server.GetCustomerOrder(context.Background(), &pb.GetCustomerOrderRequest{PageSize: 10, BookNo: "B003"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resp.CustomerOrders[0].Items))
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type PublisherServiceServer struct {
+	pb.UnimplementedPublisherServiceServer
+	db *gorm.DB
+}
+
+// NewPublisherServiceServer 用于创建 PublisherServiceServer
+func NewPublisherServiceServer(db *gorm.DB) *PublisherServiceServer {
+	return &PublisherServiceServer{
+		db: db,
+	}
+}
+
+// CreatePublisher 创建出版社
+func (s *PublisherServiceServer) CreatePublisher(ctx context.Context, req *pb.CreatePublisherRequest) (*pb.CreatePublisherResponse, error) {
+	// 验证用户输入
+	if req.GetName() == "" {
+		return nil, invalidArgumentError("name", "Name is required")
+	}
+
+	// 构建新的 Publisher 对象
+	publisher := &models.Publisher{
+		Name:        req.GetName(),
+		ContactInfo: req.GetContactInfo(),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// 写入数据库
+	if err := s.db.Create(&publisher).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "Publisher already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create publisher: %v", err)
+	}
+
+	// 返回成功的响应
+	return &pb.CreatePublisherResponse{
+		Success:  true,
+		Feedback: "Publisher created successfully",
+		Id:       publisher.ID,
+	}, nil
+}
+
+// GetPublisher 获取出版社
+func (s *PublisherServiceServer) GetPublisher(ctx context.Context, req *pb.GetPublisherRequest) (*pb.GetPublisherResponse, error) {
+	// 查询出版社
+	result, err := paginate(s.db, req, pageScope("publishers"), func(publisher *models.Publisher) (time.Time, int32) {
+		return publisher.CreatedAt, publisher.ID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建响应
+	var pbPublishers []*pb.Publisher
+	for _, publisher := range result.Items {
+		pbPublishers = append(pbPublishers, &pb.Publisher{
+			Id:          publisher.ID,
+			Name:        publisher.Name,
+			ContactInfo: publisher.ContactInfo,
+			CreatedAt:   timestamppb.New(publisher.CreatedAt),
+			UpdatedAt:   timestamppb.New(publisher.UpdatedAt),
+		})
+	}
+
+	// 返回响应
+	return &pb.GetPublisherResponse{
+		Success:       true,
+		Feedback:      "Publishers retrieved successfully",
+		Publishers:    pbPublishers,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    result.TotalCount,
+	}, nil
+}
+
+// UpdatePublisher 更新出版社信息
+func (s *PublisherServiceServer) UpdatePublisher(ctx context.Context, req *pb.UpdatePublisherRequest) (*pb.UpdatePublisherResponse, error) {
+	var publisher models.Publisher
+
+	// 查询出版社
+	if err := s.db.First(&publisher, req.GetId()).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "Publisher not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to query publisher: %v", err)
+	}
+
+	// 更新字段
+	if req.GetName() != "" {
+		publisher.Name = req.GetName()
+	}
+	if req.GetContactInfo() != "" {
+		publisher.ContactInfo = req.GetContactInfo()
+	}
+	publisher.UpdatedAt = time.Now()
+
+	// 保存更新，同时同步书籍上冗余的出版社名称
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&publisher).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Book{}).Where("publisher_id = ?", publisher.ID).Update("publisher_name", publisher.Name).Error
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "Publisher already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update publisher: %v", err)
+	}
+
+	// 返回成功的响应
+	return &pb.UpdatePublisherResponse{
+		Success:  true,
+		Feedback: "Publisher updated successfully",
+	}, nil
+}
+
+// DeletePublisher 删除出版社
+func (s *PublisherServiceServer) DeletePublisher(ctx context.Context, req *pb.DeletePublisherRequest) (*pb.DeletePublisherResponse, error) {
+	var publisher models.Publisher
+
+	// 查询出版社
+	if err := s.db.First(&publisher, req.GetId()).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "Publisher not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to query publisher: %v", err)
+	}
+
+	// 仍有书籍引用的出版社不能删除
+	var bookCount int64
+	if err := s.db.Model(&models.Book{}).Where("publisher_id = ?", publisher.ID).Count(&bookCount).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query books: %v", err)
+	}
+	if bookCount > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Publisher is still referenced by %d books", bookCount)
+	}
+
+	// 删除出版社
+	if err := s.db.Delete(&publisher).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete publisher: %v", err)
+	}
+
+	// 返回成功的响应
+	return &pb.DeletePublisherResponse{
+		Success:  true,
+		Feedback: "Publisher deleted successfully",
+	}, nil
+}
+
+// findPublisher 根据 ID 或名称查找出版社，只给出名称时如果不存在则自动创建
+func findPublisher(db *gorm.DB, id int32, name string) (*models.Publisher, error) {
+	var publisher models.Publisher
+	if id != 0 {
+		if err := db.First(&publisher, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, status.Error(codes.NotFound, "Publisher not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to query publisher: %v", err)
+		}
+		return &publisher, nil
+	}
+
+	if err := db.Where(models.Publisher{Name: name}).FirstOrCreate(&publisher).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create publisher: %v", err)
+	}
+	return &publisher, nil
+}
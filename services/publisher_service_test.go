@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPublisherService(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewPublisherServiceServer(db)
+	bookServer := NewBookServiceServer(db)
+
+	// 创建出版社
+	createResp, err := server.CreatePublisher(context.Background(), &pb.CreatePublisherRequest{
+		Name:        "Tech Press",
+		ContactInfo: "tech@example.com",
+	})
+	assert.NoError(t, err)
+	assert.True(t, createResp.Success)
+	assert.Equal(t, "Publisher created successfully", createResp.Feedback)
+
+	// 通过 publisher_id 创建书籍
+	bookResp, err := bookServer.CreateBook(context.Background(), &pb.CreateBookRequest{
+		BookNo:        "B001",
+		Title:         "Go Programming",
+		PublisherId:   createResp.Id,
+		Price:         50,
+		StockQuantity: 10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, bookResp.Success)
+
+	// 通过 publisher_name 创建书籍时自动创建出版社
+	bookResp, err = bookServer.CreateBook(context.Background(), &pb.CreateBookRequest{
+		BookNo:        "B002",
+		Title:         "Rust Programming",
+		PublisherName: "New Press",
+		Price:         60,
+		StockQuantity: 10,
+	})
+	assert.NoError(t, err)
+	assert.True(t, bookResp.Success)
+
+	getResp, err := server.GetPublisher(context.Background(), &pb.GetPublisherRequest{Start: 0, Stop: 9})
+	assert.NoError(t, err)
+	assert.True(t, getResp.Success)
+	assert.Equal(t, 2, len(getResp.Publishers))
+	assert.Equal(t, "Tech Press", getResp.Publishers[0].Name)
+	assert.Equal(t, "New Press", getResp.Publishers[1].Name)
+
+	// 修改出版社名称会同步到书籍
+	updateResp, err := server.UpdatePublisher(context.Background(), &pb.UpdatePublisherRequest{
+		Id:   createResp.Id,
+		Name: "Tech Press International",
+	})
+	assert.NoError(t, err)
+	assert.True(t, updateResp.Success)
+
+	var book models.Book
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, "Tech Press International", book.PublisherName)
+	assert.Equal(t, createResp.Id, *book.PublisherID)
+
+	// 仍被书籍引用的出版社不能删除
+	_, err = server.DeletePublisher(context.Background(), &pb.DeletePublisherRequest{Id: createResp.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 不存在的出版社
+	_, err = server.DeletePublisher(context.Background(), &pb.DeletePublisherRequest{Id: 100})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "Publisher not found", status.Convert(err).Message())
+}
+
+func TestSeriesService(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewSeriesServiceServer(db)
+	bookServer := NewBookServiceServer(db)
+
+	createResp, err := server.CreateSeries(context.Background(), &pb.CreateSeriesRequest{
+		Name:        "Classics",
+		Description: "Classic literature",
+	})
+	assert.NoError(t, err)
+	assert.True(t, createResp.Success)
+
+	// 创建属于丛书的书籍
+	bookResp, err := bookServer.CreateBook(context.Background(), &pb.CreateBookRequest{
+		BookNo:        "B001",
+		Title:         "Moby Dick",
+		PublisherName: "Old Press",
+		SeriesId:      createResp.Id,
+		Price:         30,
+		StockQuantity: 5,
+	})
+	assert.NoError(t, err)
+	assert.True(t, bookResp.Success)
+
+	getBookResp, err := bookServer.GetBook(context.Background(), &pb.GetBookRequest{Start: 0, Stop: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(getBookResp.Books))
+	assert.Equal(t, createResp.Id, getBookResp.Books[0].SeriesId)
+	assert.Equal(t, "Classics", getBookResp.Books[0].SeriesName)
+
+	// 不存在的丛书
+	_, err = bookServer.CreateBook(context.Background(), &pb.CreateBookRequest{
+		BookNo:        "B002",
+		Title:         "Unknown",
+		PublisherName: "Old Press",
+		SeriesId:      100,
+		Price:         30,
+		StockQuantity: 5,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "Series not found", status.Convert(err).Message())
+
+	// 删除丛书后书籍不再关联
+	deleteResp, err := server.DeleteSeries(context.Background(), &pb.DeleteSeriesRequest{Id: createResp.Id})
+	assert.NoError(t, err)
+	assert.True(t, deleteResp.Success)
+
+	var book models.Book
+	db.First(&book, "book_no = ?", "B001")
+	assert.Nil(t, book.SeriesID)
+}
@@ -0,0 +1,298 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 生成采购单时选择供应商的策略，配置文件中使用这些名称
+const (
+	// SupplierPolicyCheapest 选择单价最低的供应商
+	SupplierPolicyCheapest = "cheapest"
+	// SupplierPolicyMostStock 选择可供数量最多的供应商
+	SupplierPolicyMostStock = "most_stock"
+	// SupplierPolicyPreferred 优先选择首选供应商，没有可用的首选供应商时退回单价最低
+	SupplierPolicyPreferred = "preferred"
+)
+
+var pbSupplierPolicies = map[pb.SupplierPolicy]string{
+	pb.SupplierPolicy_SUPPLIER_POLICY_CHEAPEST:   SupplierPolicyCheapest,
+	pb.SupplierPolicy_SUPPLIER_POLICY_MOST_STOCK: SupplierPolicyMostStock,
+	pb.SupplierPolicy_SUPPLIER_POLICY_PREFERRED:  SupplierPolicyPreferred,
+}
+
+// supplyOffer 是某个供应商对一本书的供货条件
+type supplyOffer struct {
+	supplyBook *models.SupplyBook
+	supplier   *models.Supplier
+}
+
+// plannedItem 是合并同一本书的未完成缺书登记后得到的一行采购
+type plannedItem struct {
+	item          *models.PurchaseOrderItem
+	supplyBook    *models.SupplyBook
+	stockRequests []*models.StockRequest
+}
+
+// plannedPurchaseOrder 是向同一个供应商采购的多行采购单
+type plannedPurchaseOrder struct {
+	purchaseOrder *models.PurchaseOrder
+	items         []*plannedItem
+}
+
+// GeneratePurchaseOrdersFromStockRequests 根据未完成的缺书记录生成采购单。
+// 同一本书的缺书登记合并为一行，每行按策略从供货目录中选择能够足量供货的供应商，同一供应商的各行合并为一个采购单。
+// 没有这样的供应商时缺书登记保持未完成并在响应中返回，dry_run 时只返回将要生成的采购单。
+// 整个生成在一个事务中完成，任何一步失败时不会留下部分生成的采购单
+func (s *PurchaseOrderServiceServer) GeneratePurchaseOrdersFromStockRequests(ctx context.Context, req *pb.GeneratePurchaseOrdersRequest) (*pb.GeneratePurchaseOrdersResponse, error) {
+	policy := s.SupplierPolicy
+	if req.GetSupplierPolicy() != pb.SupplierPolicy_SUPPLIER_POLICY_UNSPECIFIED {
+		var ok bool
+		if policy, ok = pbSupplierPolicies[req.GetSupplierPolicy()]; !ok {
+			return nil, invalidArgumentError("supplier_policy", "unknown supplier policy")
+		}
+	}
+
+	var stockRequests []*models.StockRequest
+	var plannedOrders []*plannedPurchaseOrder
+	var unfilled []*models.StockRequest
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 查询所有 Finished=false 的缺书记录并加行锁，并发的生成依次处理
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("finished = ?", false).Order("id").Find(&stockRequests).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to query stock requests: %v", err)
+		}
+		var err error
+		if plannedOrders, unfilled, err = planPurchaseOrders(tx, stockRequests, policy); err != nil {
+			return err
+		}
+		if req.GetDryRun() {
+			return nil
+		}
+		for _, planned := range plannedOrders {
+			if err := createPlannedPurchaseOrder(tx, planned); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	var purchaseOrders []*pb.PurchaseOrder
+	for _, planned := range plannedOrders {
+		purchaseOrders = append(purchaseOrders, toPbPurchaseOrder(planned.purchaseOrder))
+	}
+	var pbUnfilled []*pb.StockRequest
+	for _, stockRequest := range unfilled {
+		pbUnfilled = append(pbUnfilled, toPbStockRequest(stockRequest))
+	}
+
+	feedback := "Purchase orders generated successfully"
+	if req.GetDryRun() {
+		feedback = "Purchase orders planned, nothing was written"
+	}
+	if len(unfilled) > 0 {
+		feedback = fmt.Sprintf("%s, %d stock requests have no supplier with enough quantity", feedback, len(unfilled))
+	}
+	return &pb.GeneratePurchaseOrdersResponse{
+		Success:               true,
+		Feedback:              feedback,
+		PurchaseOrders:        purchaseOrders,
+		UnfilledStockRequests: pbUnfilled,
+		Results:               stockRequestResults(stockRequests, plannedOrders, req.GetDryRun()),
+	}, nil
+}
+
+// stockRequestResults 按缺书登记的顺序列出每条缺书登记的处理结果
+func stockRequestResults(stockRequests []*models.StockRequest, plannedOrders []*plannedPurchaseOrder, dryRun bool) []*pb.StockRequestResult {
+	orderByRequest := make(map[int32]*models.PurchaseOrder)
+	for _, planned := range plannedOrders {
+		for _, item := range planned.items {
+			for _, stockRequest := range item.stockRequests {
+				orderByRequest[stockRequest.ID] = planned.purchaseOrder
+			}
+		}
+	}
+
+	results := make([]*pb.StockRequestResult, 0, len(stockRequests))
+	for _, stockRequest := range stockRequests {
+		result := &pb.StockRequestResult{
+			StockRequestId: stockRequest.ID,
+			BookNo:         stockRequest.BookNo,
+			Quantity:       stockRequest.Quantity,
+			Outcome:        pb.StockRequestOutcome_STOCK_REQUEST_OUTCOME_UNFILLED,
+		}
+		if purchaseOrder, ok := orderByRequest[stockRequest.ID]; ok {
+			result.Outcome = pb.StockRequestOutcome_STOCK_REQUEST_OUTCOME_ORDERED
+			if dryRun {
+				result.Outcome = pb.StockRequestOutcome_STOCK_REQUEST_OUTCOME_PLANNED
+			}
+			result.PurchaseOrderId = purchaseOrder.ID
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// planPurchaseOrders 按书号合并缺书登记并为每本书选择供应商，返回按供应商分组的采购单和没有供应商能够供货的缺书登记
+func planPurchaseOrders(db *gorm.DB, stockRequests []*models.StockRequest, policy string) ([]*plannedPurchaseOrder, []*models.StockRequest, error) {
+	// 按书号合并，书的信息取最早的缺书登记
+	var items []*plannedItem
+	itemByBookNo := make(map[string]*plannedItem)
+	for _, stockRequest := range stockRequests {
+		planned, ok := itemByBookNo[stockRequest.BookNo]
+		if !ok {
+			planned = &plannedItem{item: &models.PurchaseOrderItem{
+				BookNo:    stockRequest.BookNo,
+				Title:     stockRequest.Title,
+				Publisher: stockRequest.Publisher,
+				Author:    stockRequest.Author,
+			}}
+			itemByBookNo[stockRequest.BookNo] = planned
+			items = append(items, planned)
+		}
+		planned.item.Quantity += stockRequest.Quantity
+		planned.stockRequests = append(planned.stockRequests, stockRequest)
+	}
+
+	// 按选中的供应商分组
+	now := time.Now()
+	var plannedOrders []*plannedPurchaseOrder
+	orderBySupplier := make(map[int32]*plannedPurchaseOrder)
+	var unfilled []*models.StockRequest
+	for _, planned := range items {
+		offer, err := selectSupplyOffer(db, planned.item.BookNo, planned.item.Quantity, policy)
+		if err != nil {
+			return nil, nil, err
+		}
+		if offer == nil {
+			unfilled = append(unfilled, planned.stockRequests...)
+			continue
+		}
+		planned.supplyBook = offer.supplyBook
+		planned.item.UnitCost = offer.supplyBook.Price
+
+		plannedOrder, ok := orderBySupplier[offer.supplier.ID]
+		if !ok {
+			supplierID := offer.supplier.ID
+			plannedOrder = &plannedPurchaseOrder{purchaseOrder: &models.PurchaseOrder{
+				Supplier:   offer.supplier.Name,
+				SupplierID: &supplierID,
+				OrderDate:  now.Format("2006-01-02"),
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}}
+			orderBySupplier[supplierID] = plannedOrder
+			plannedOrders = append(plannedOrders, plannedOrder)
+		}
+		plannedOrder.items = append(plannedOrder.items, planned)
+		plannedOrder.purchaseOrder.Items = append(plannedOrder.purchaseOrder.Items, planned.item)
+	}
+	return plannedOrders, unfilled, nil
+}
+
+// createPlannedPurchaseOrder 在事务 tx 中扣减供应商的可供数量、写入采购单并完成对应的缺书登记，
+// 等待到货的客户在采购单到货时处理。可供数量不足或缺书登记已被其他生成处理时返回 Aborted，调用者可以重试
+func createPlannedPurchaseOrder(tx *gorm.DB, planned *plannedPurchaseOrder) error {
+	var stockRequestIDs []int32
+	for _, item := range planned.items {
+		// 条件更新防止并发生成时超出供应商的可供数量
+		result := tx.Model(&models.SupplyBook{}).
+			Where("id = ? AND quantity >= ?", item.supplyBook.ID, item.item.Quantity).
+			Update("quantity", gorm.Expr("quantity - ?", item.item.Quantity))
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "Failed to update supply book: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return status.Errorf(codes.Aborted, "Supply of book %s changed during generation", item.item.BookNo)
+		}
+		for _, stockRequest := range item.stockRequests {
+			stockRequestIDs = append(stockRequestIDs, stockRequest.ID)
+		}
+	}
+
+	if err := tx.Create(planned.purchaseOrder).Error; err != nil {
+		return status.Errorf(codes.Internal, "Failed to create purchase order: %v", err)
+	}
+
+	// 只完成仍未完成的缺书登记，数量不符说明另一个生成已经处理了其中一些
+	result := tx.Model(&models.StockRequest{}).
+		Where("id IN ? AND finished = ?", stockRequestIDs, false).
+		Updates(map[string]interface{}{
+			"finished":          true,
+			"purchase_order_id": planned.purchaseOrder.ID,
+			"updated_at":        time.Now(),
+		})
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "Failed to update stock requests: %v", result.Error)
+	}
+	if result.RowsAffected != int64(len(stockRequestIDs)) {
+		return status.Error(codes.Aborted, "Stock requests were converted by a concurrent generation")
+	}
+	return nil
+}
+
+// selectSupplyOffer 按策略从供货目录中选出能够供应 quantity 本的供应商，没有时返回 nil
+func selectSupplyOffer(db *gorm.DB, bookNo string, quantity int32, policy string) (*supplyOffer, error) {
+	var supplyBooks []*models.SupplyBook
+	if err := db.Where("book_no = ? AND quantity >= ?", bookNo, quantity).Find(&supplyBooks).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to query supply books: %v", err)
+	}
+	if len(supplyBooks) == 0 {
+		return nil, nil
+	}
+
+	supplierIDs := make([]int32, 0, len(supplyBooks))
+	for _, supplyBook := range supplyBooks {
+		supplierIDs = append(supplierIDs, supplyBook.SupplierID)
+	}
+	var suppliers []*models.Supplier
+	if err := db.Where("id IN ?", supplierIDs).Find(&suppliers).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to query suppliers: %v", err)
+	}
+	supplierByID := make(map[int32]*models.Supplier, len(suppliers))
+	for _, supplier := range suppliers {
+		supplierByID[supplier.ID] = supplier
+	}
+
+	// 供应商已被删除的供货记录不再参与选择
+	offers := make([]*supplyOffer, 0, len(supplyBooks))
+	for _, supplyBook := range supplyBooks {
+		if supplier, ok := supplierByID[supplyBook.SupplierID]; ok {
+			offers = append(offers, &supplyOffer{supplyBook: supplyBook, supplier: supplier})
+		}
+	}
+	if len(offers) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(offers, func(i, j int) bool {
+		a, b := offers[i], offers[j]
+		switch policy {
+		case SupplierPolicyMostStock:
+			if a.supplyBook.Quantity != b.supplyBook.Quantity {
+				return a.supplyBook.Quantity > b.supplyBook.Quantity
+			}
+		case SupplierPolicyPreferred:
+			if a.supplier.Preferred != b.supplier.Preferred {
+				return a.supplier.Preferred
+			}
+		}
+		// 其余情况按单价从低到高，单价相同时选择编号较小的供应商，保证结果稳定
+		if a.supplyBook.Price != b.supplyBook.Price {
+			return a.supplyBook.Price < b.supplyBook.Price
+		}
+		return a.supplier.ID < b.supplier.ID
+	})
+	return offers[0], nil
+}
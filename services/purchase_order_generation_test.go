@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestGeneratePurchaseOrdersBySupplierPolicy(t *testing.T) {
+	db := setupTestDB(t)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	ctx := context.Background()
+
+	// 三个供应商都供应 B001：便宜的库存少，贵的库存多，首选供应商价格居中
+	cheap := &models.Supplier{Name: "Cheap"}
+	bulk := &models.Supplier{Name: "Bulk"}
+	preferred := &models.Supplier{Name: "Preferred", Preferred: true}
+	db.Create(cheap)
+	db.Create(bulk)
+	db.Create(preferred)
+	db.Create(&models.SupplyBook{BookNo: "B001", Title: "Book 1", SupplierID: cheap.ID, Price: 50, Quantity: 12})
+	db.Create(&models.SupplyBook{BookNo: "B001", Title: "Book 1", SupplierID: bulk.ID, Price: 80, Quantity: 100})
+	db.Create(&models.SupplyBook{BookNo: "B001", Title: "Book 1", SupplierID: preferred.ID, Price: 60, Quantity: 30})
+
+	generate := func(policy pb.SupplierPolicy, quantity int32) *pb.GeneratePurchaseOrdersResponse {
+		db.Create(&models.StockRequest{BookNo: "B001", Title: "Book 1", Quantity: quantity, RequestDate: "2023-01-01"})
+		resp, err := purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(ctx, &pb.GeneratePurchaseOrdersRequest{SupplierPolicy: policy})
+		assert.NoError(t, err)
+		return resp
+	}
+	supplyQuantity := func(supplier *models.Supplier) int32 {
+		var supplyBook models.SupplyBook
+		db.First(&supplyBook, "supplier_id = ? AND book_no = ?", supplier.ID, "B001")
+		return supplyBook.Quantity
+	}
+
+	// 未指定策略时使用服务器默认的最低单价，并扣减供应商的可供数量
+	resp := generate(pb.SupplierPolicy_SUPPLIER_POLICY_UNSPECIFIED, 10)
+	assert.Len(t, resp.PurchaseOrders, 1)
+	assert.Equal(t, "Cheap", resp.PurchaseOrders[0].Supplier)
+	assert.Equal(t, cheap.ID, resp.PurchaseOrders[0].SupplierId)
+	assert.Equal(t, int32(50), resp.PurchaseOrders[0].UnitCost)
+	assert.Equal(t, int32(2), supplyQuantity(cheap))
+
+	// 最便宜的供应商数量不够时选择下一个
+	resp = generate(pb.SupplierPolicy_SUPPLIER_POLICY_CHEAPEST, 10)
+	assert.Equal(t, "Preferred", resp.PurchaseOrders[0].Supplier)
+
+	resp = generate(pb.SupplierPolicy_SUPPLIER_POLICY_MOST_STOCK, 10)
+	assert.Equal(t, "Bulk", resp.PurchaseOrders[0].Supplier)
+	assert.Equal(t, int32(80), resp.PurchaseOrders[0].UnitCost)
+
+	// 首选供应商数量不够时退回最低单价
+	resp = generate(pb.SupplierPolicy_SUPPLIER_POLICY_PREFERRED, 20)
+	assert.Equal(t, "Preferred", resp.PurchaseOrders[0].Supplier)
+	assert.Equal(t, int32(0), supplyQuantity(preferred))
+	resp = generate(pb.SupplierPolicy_SUPPLIER_POLICY_PREFERRED, 10)
+	assert.Equal(t, "Bulk", resp.PurchaseOrders[0].Supplier)
+
+	// 服务器默认策略可以配置
+	purchaseOrderServer.SupplierPolicy = SupplierPolicyMostStock
+	resp = generate(pb.SupplierPolicy_SUPPLIER_POLICY_UNSPECIFIED, 10)
+	assert.Equal(t, "Bulk", resp.PurchaseOrders[0].Supplier)
+	assert.Equal(t, int32(70), supplyQuantity(bulk))
+
+	// 没有供应商能够足量供货的缺书登记保持未完成，下次生成时再处理
+	resp = generate(pb.SupplierPolicy_SUPPLIER_POLICY_CHEAPEST, 500)
+	assert.True(t, resp.Success)
+	assert.Empty(t, resp.PurchaseOrders)
+	assert.Len(t, resp.UnfilledStockRequests, 1)
+	assert.Equal(t, int32(500), resp.UnfilledStockRequests[0].Quantity)
+	assert.Contains(t, resp.Feedback, "1 stock requests have no supplier")
+	var stockRequest models.StockRequest
+	db.First(&stockRequest, resp.UnfilledStockRequests[0].Id)
+	assert.False(t, stockRequest.Finished)
+
+	// 同一供应商不能重复供应同一本书，不同供应商可以
+	assert.Error(t, db.Create(&models.SupplyBook{BookNo: "B001", Title: "Book 1", SupplierID: cheap.ID}).Error)
+}
+
+func TestGenerateConsolidatedPurchaseOrders(t *testing.T) {
+	db := setupTestDB(t)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	ctx := context.Background()
+
+	// A 供应 B001 和 B002，B 只供应 B003
+	supplierA := &models.Supplier{Name: "Supplier A"}
+	supplierB := &models.Supplier{Name: "Supplier B"}
+	db.Create(supplierA)
+	db.Create(supplierB)
+	db.Create(&models.SupplyBook{BookNo: "B001", Title: "Book 1", SupplierID: supplierA.ID, Price: 50, Quantity: 100})
+	db.Create(&models.SupplyBook{BookNo: "B002", Title: "Book 2", SupplierID: supplierA.ID, Price: 40, Quantity: 100})
+	db.Create(&models.SupplyBook{BookNo: "B003", Title: "Book 3", SupplierID: supplierB.ID, Price: 30, Quantity: 5})
+	for _, request := range []struct {
+		bookNo   string
+		quantity int32
+	}{{"B001", 3}, {"B002", 4}, {"B001", 5}, {"B003", 2}, {"B001", 1}, {"B003", 4}} {
+		db.Create(&models.StockRequest{BookNo: request.bookNo, Title: "Book", Quantity: request.quantity, RequestDate: "2023-01-01"})
+	}
+
+	// dry_run 只返回将要生成的采购单，不写入数据库
+	resp, err := purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(ctx, &pb.GeneratePurchaseOrdersRequest{DryRun: true})
+	assert.NoError(t, err)
+	assert.Len(t, resp.PurchaseOrders, 1)
+	assert.Equal(t, int32(0), resp.PurchaseOrders[0].Id)
+	assert.Len(t, resp.UnfilledStockRequests, 2)
+	var count int64
+	db.Model(&models.PurchaseOrder{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+	db.Model(&models.StockRequest{}).Where("finished = ?", true).Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	// 同一本书的缺书登记合并为一行，同一供应商的书合并为一个采购单
+	resp, err = purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(ctx, &pb.GeneratePurchaseOrdersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.PurchaseOrders, 1)
+	purchaseOrder := resp.PurchaseOrders[0]
+	assert.NotZero(t, purchaseOrder.Id)
+	assert.Equal(t, "Supplier A", purchaseOrder.Supplier)
+	assert.Equal(t, int32(13), purchaseOrder.Quantity)
+	assert.Empty(t, purchaseOrder.BookNo)
+	assert.Len(t, purchaseOrder.Items, 2)
+	assert.Equal(t, "B001", purchaseOrder.Items[0].BookNo)
+	assert.Equal(t, int32(9), purchaseOrder.Items[0].Quantity)
+	assert.Equal(t, int32(50), purchaseOrder.Items[0].UnitCost)
+	assert.Equal(t, "B002", purchaseOrder.Items[1].BookNo)
+	assert.Equal(t, int32(4), purchaseOrder.Items[1].Quantity)
+
+	// 合并后的 B003 超过了供应商的数量，两条缺书登记都保持未完成
+	assert.Len(t, resp.UnfilledStockRequests, 2)
+	for _, stockRequest := range resp.UnfilledStockRequests {
+		assert.Equal(t, "B003", stockRequest.BookNo)
+		assert.False(t, stockRequest.Finished)
+	}
+	db.Model(&models.StockRequest{}).Where("finished = ?", true).Count(&count)
+	assert.Equal(t, int64(4), count)
+
+	// 按书号查询可以找到多行采购单
+	getResp, err := purchaseOrderServer.GetPurchaseOrder(ctx, &pb.GetPurchaseOrderRequest{PageSize: 10, BookNo: "B002"})
+	assert.NoError(t, err)
+	assert.Len(t, getResp.PurchaseOrders, 1)
+	assert.Len(t, getResp.PurchaseOrders[0].Items, 2)
+}
+
+func TestGeneratePurchaseOrdersAtomically(t *testing.T) {
+	db := setupTestDB(t)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	ctx := context.Background()
+
+	supplierA := &models.Supplier{Name: "Supplier A"}
+	supplierB := &models.Supplier{Name: "Supplier B"}
+	db.Create(supplierA)
+	db.Create(supplierB)
+	db.Create(&models.SupplyBook{BookNo: "B001", Title: "Book 1", SupplierID: supplierA.ID, Price: 50, Quantity: 100})
+	db.Create(&models.SupplyBook{BookNo: "B002", Title: "Book 2", SupplierID: supplierB.ID, Price: 40, Quantity: 100})
+	for _, bookNo := range []string{"B001", "B002", "B003", "B001"} {
+		db.Create(&models.StockRequest{BookNo: bookNo, Title: "Book", Quantity: 2, RequestDate: "2023-01-01"})
+	}
+	unfinished := func() int64 {
+		var count int64
+		db.Model(&models.StockRequest{}).Where("finished = ?", false).Count(&count)
+		return count
+	}
+
+	// 第二个采购单写入失败时整个生成回滚
+	failing := true
+	created := 0
+	db.Callback().Create().Before("gorm:create").Register("test:fail_purchase_order", func(tx *gorm.DB) {
+		if failing && tx.Statement.Table == "purchase_orders" {
+			if created++; created == 2 {
+				tx.AddError(errors.New("disk full"))
+			}
+		}
+	})
+	_, err := purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(ctx, &pb.GeneratePurchaseOrdersRequest{})
+	assert.Error(t, err)
+	var count int64
+	db.Model(&models.PurchaseOrder{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+	assert.Equal(t, int64(4), unfinished())
+	var supplyBook models.SupplyBook
+	db.First(&supplyBook, "book_no = ?", "B001")
+	assert.Equal(t, int32(100), supplyBook.Quantity)
+
+	// 成功后返回每条缺书登记的结果和生成的采购单
+	failing = false
+	resp, err := purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(ctx, &pb.GeneratePurchaseOrdersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.PurchaseOrders, 2)
+	assert.Len(t, resp.Results, 4)
+	orderA, orderB := resp.PurchaseOrders[0].Id, resp.PurchaseOrders[1].Id
+	for i, expected := range []struct {
+		outcome         pb.StockRequestOutcome
+		purchaseOrderID int32
+	}{
+		{pb.StockRequestOutcome_STOCK_REQUEST_OUTCOME_ORDERED, orderA},
+		{pb.StockRequestOutcome_STOCK_REQUEST_OUTCOME_ORDERED, orderB},
+		{pb.StockRequestOutcome_STOCK_REQUEST_OUTCOME_UNFILLED, 0},
+		{pb.StockRequestOutcome_STOCK_REQUEST_OUTCOME_ORDERED, orderA},
+	} {
+		assert.Equal(t, int32(i+1), resp.Results[i].StockRequestId)
+		assert.Equal(t, expected.outcome, resp.Results[i].Outcome)
+		assert.Equal(t, expected.purchaseOrderID, resp.Results[i].PurchaseOrderId)
+	}
+	var stockRequest models.StockRequest
+	db.First(&stockRequest, 4)
+	assert.True(t, stockRequest.Finished)
+	assert.Equal(t, orderA, *stockRequest.PurchaseOrderID)
+
+	// 再次生成不会重复处理已经完成的缺书登记
+	resp, err = purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(ctx, &pb.GeneratePurchaseOrdersRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.PurchaseOrders)
+	assert.Len(t, resp.Results, 1)
+	assert.Equal(t, pb.StockRequestOutcome_STOCK_REQUEST_OUTCOME_UNFILLED, resp.Results[0].Outcome)
+
+	// 计划之后缺书登记被另一个生成处理时放弃写入
+	db.Create(&models.StockRequest{BookNo: "B001", Title: "Book", Quantity: 2, RequestDate: "2023-01-01"})
+	var stockRequests []*models.StockRequest
+	db.Where("finished = ?", false).Order("id").Find(&stockRequests)
+	plannedOrders, _, err := planPurchaseOrders(db, stockRequests, SupplierPolicyCheapest)
+	assert.NoError(t, err)
+	assert.Len(t, plannedOrders, 1)
+	db.Model(&models.StockRequest{}).Where("id = ?", 5).Update("finished", true)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return createPlannedPurchaseOrder(tx, plannedOrders[0])
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	db.Model(&models.PurchaseOrder{}).Count(&count)
+	assert.Equal(t, int64(2), count)
+}
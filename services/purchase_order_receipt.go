@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// finishReceiptID 是 UpdatePurchaseOrder 把采购单标记为完成时登记剩余到货使用的到货单号，
+// 调用者不能使用这个单号，否则真实的到货会被当作重复提交
+const finishReceiptID = "finished"
+
+// ReceivePurchaseOrder 登记采购单中一种书的一次到货，同一个到货单号重复提交时只入库一次
+func (s *PurchaseOrderServiceServer) ReceivePurchaseOrder(ctx context.Context, req *pb.ReceivePurchaseOrderRequest) (*pb.ReceivePurchaseOrderResponse, error) {
+	// 验证用户输入
+	if req.GetReceiptId() == "" {
+		return nil, invalidArgumentError("receipt_id", "Receipt ID is required")
+	}
+	if req.GetReceiptId() == finishReceiptID {
+		return nil, invalidArgumentError("receipt_id", fmt.Sprintf("Receipt ID %q is reserved", finishReceiptID))
+	}
+
+	// 数量为 0 时只能用来提前结束采购单
+	if req.GetQuantity() < 0 || (req.GetQuantity() == 0 && !req.GetClose()) {
+		return nil, invalidArgumentError("quantity", "Quantity must be greater than 0")
+	}
+
+	var purchaseOrder models.PurchaseOrder
+	var item *models.PurchaseOrderItem
+	feedback := "Purchase order receipt recorded successfully"
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 查询采购单并加行锁，同一采购单的到货依次处理
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items", orderByID).First(&purchaseOrder, req.GetId()).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Error(codes.NotFound, "Purchase order not found")
+			}
+			return status.Errorf(codes.Internal, "failed to query purchase order: %v", err)
+		}
+		var err error
+		if item, err = findPurchaseOrderItem(&purchaseOrder, req.GetBookNo()); err != nil {
+			return err
+		}
+
+		// 重复提交的到货直接返回当前状态，数量不同说明到货单号被误用
+		var receipt models.PurchaseOrderReceipt
+		err = tx.Where("purchase_order_id = ? AND receipt_id = ? AND book_no = ?", purchaseOrder.ID, req.GetReceiptId(), item.BookNo).First(&receipt).Error
+		if err == nil {
+			if receipt.Quantity != req.GetQuantity() {
+				return status.Errorf(codes.FailedPrecondition, "Receipt %s was already recorded with quantity %d", receipt.ReceiptID, receipt.Quantity)
+			}
+			feedback = "Purchase order receipt already recorded"
+			return nil
+		}
+		if err != gorm.ErrRecordNotFound {
+			return status.Errorf(codes.Internal, "failed to query purchase order receipt: %v", err)
+		}
+
+		if purchaseOrder.Finished {
+			return status.Error(codes.FailedPrecondition, "Purchase order is already finished")
+		}
+
+		waitlistFeedback, err := receivePurchaseOrder(tx, &purchaseOrder, item, req.GetReceiptId(), req.GetQuantity(), req.GetClose(), callerActor(ctx))
+		if err != nil {
+			return err
+		}
+		if waitlistFeedback != "" {
+			feedback = fmt.Sprintf("%s\n%s", feedback, waitlistFeedback)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	// 返回成功的响应
+	return &pb.ReceivePurchaseOrderResponse{
+		Success:          true,
+		Feedback:         feedback,
+		ReceivedQuantity: item.ReceivedQuantity,
+		Finished:         purchaseOrder.Finished,
+	}, nil
+}
+
+// findPurchaseOrderItem 返回采购单中 bookNo 对应的一行，采购单只有一行时 bookNo 可以为空
+func findPurchaseOrderItem(purchaseOrder *models.PurchaseOrder, bookNo string) (*models.PurchaseOrderItem, error) {
+	if bookNo == "" {
+		if len(purchaseOrder.Items) == 1 {
+			return purchaseOrder.Items[0], nil
+		}
+		return nil, invalidArgumentError("book_no", "BookNo is required for purchase orders with several items")
+	}
+	for _, item := range purchaseOrder.Items {
+		if item.BookNo == bookNo {
+			return item, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "Purchase order item not found")
+}
+
+// receivePurchaseOrder 在事务 tx 中为已加锁的采购单的一行登记一次到货：增加库存并记为入库，按先后顺序处理等待到货的客户。
+// 各行累计到货都达到采购数量或 closeOrder 为 true 时结束采购单。返回等待客户的处理说明，没有等待的客户时返回空字符串。
+func receivePurchaseOrder(tx *gorm.DB, purchaseOrder *models.PurchaseOrder, item *models.PurchaseOrderItem, receiptID string, quantity int32, closeOrder bool, actor string) (string, error) {
+	// 写入到货记录，唯一索引保证同一个到货单号只入库一次
+	now := time.Now()
+	receipt := &models.PurchaseOrderReceipt{
+		PurchaseOrderID: purchaseOrder.ID,
+		ReceiptID:       receiptID,
+		BookNo:          item.BookNo,
+		Quantity:        quantity,
+		Actor:           actor,
+		CreatedAt:       now,
+	}
+	if err := tx.Create(receipt).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return "", status.Errorf(codes.AlreadyExists, "Receipt %s already exists", receiptID)
+		}
+		return "", status.Errorf(codes.Internal, "failed to create purchase order receipt: %v", err)
+	}
+
+	// 更新这一行的到货数量，各行全部到货后结束采购单
+	item.ReceivedQuantity += quantity
+	item.UpdatedAt = now
+	if err := tx.Model(&models.PurchaseOrderItem{}).Where("id = ?", item.ID).Updates(map[string]interface{}{
+		"received_quantity": item.ReceivedQuantity,
+		"updated_at":        now,
+	}).Error; err != nil {
+		return "", status.Errorf(codes.Internal, "failed to update purchase order item: %v", err)
+	}
+	purchaseOrder.Finished = closeOrder || purchaseOrderReceived(purchaseOrder)
+	purchaseOrder.UpdatedAt = now
+	if err := tx.Model(&models.PurchaseOrder{}).Where("id = ?", purchaseOrder.ID).Updates(map[string]interface{}{
+		"finished":   purchaseOrder.Finished,
+		"updated_at": now,
+	}).Error; err != nil {
+		return "", status.Errorf(codes.Internal, "failed to update purchase order: %v", err)
+	}
+	if quantity == 0 {
+		return "", nil
+	}
+
+	// 更新书籍库存数量
+	var book models.Book
+	if err := tx.Where("book_no = ?", item.BookNo).First(&book).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			return "", status.Errorf(codes.Internal, "failed to find book: %v", err)
+		}
+		// 如果书籍不存在，创建书籍记录
+		book = models.Book{
+			BookNo:        item.BookNo,
+			Title:         item.Title,
+			PublisherName: item.Publisher,
+			Authors:       item.Author,
+			StockQuantity: quantity,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if err := tx.Create(&book).Error; err != nil {
+			return "", status.Errorf(codes.Internal, "failed to create book: %v", err)
+		}
+	} else {
+		result := tx.Model(&models.Book{}).Where("id = ?", book.ID).
+			Update("stock_quantity", gorm.Expr("stock_quantity + ?", quantity))
+		if result.Error != nil {
+			return "", status.Errorf(codes.Internal, "failed to update book stock quantity: %v", result.Error)
+		}
+	}
+	if err := recordStockMovement(tx, item.BookNo, quantity, models.StockMovementReceipt, purchaseOrder.ID, actor); err != nil {
+		return "", err
+	}
+
+	return fulfilWaitlist(tx, item.BookNo, actor)
+}
+
+// purchaseOrderReceived 判断采购单的各行是否都已经全部到货
+func purchaseOrderReceived(purchaseOrder *models.PurchaseOrder) bool {
+	for _, item := range purchaseOrder.Items {
+		if item.ReceivedQuantity < item.Quantity {
+			return false
+		}
+	}
+	return true
+}
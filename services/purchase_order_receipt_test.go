@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReceivePurchaseOrder(t *testing.T) {
+	db := setupTestDB(t)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	ctx := context.Background()
+
+	db.Create(&models.Book{BookNo: "B001", Title: "Book 1", PublisherName: "Publisher", Authors: "Author"})
+	for i := 0; i < 3; i++ {
+		db.Create(&models.PurchaseOrder{Supplier: "Supplier", OrderDate: "2023-01-01", Items: []*models.PurchaseOrderItem{{BookNo: "B001", Title: "Book 1", Publisher: "Publisher", Author: "Author", Quantity: 10}}})
+	}
+	stock := func() int32 {
+		var book models.Book
+		db.First(&book, "book_no = ?", "B001")
+		return book.StockQuantity
+	}
+
+	// 到货单号必填，数量为 0 时只能用来结束采购单
+	_, err := purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, Quantity: 4})
+	assert.Equal(t, "receipt_id", badRequestField(t, err))
+	_, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: finishReceiptID, Quantity: 4})
+	assert.Equal(t, "receipt_id", badRequestField(t, err))
+	_, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R1"})
+	assert.Equal(t, "quantity", badRequestField(t, err))
+
+	// 部分到货
+	resp, err := purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R1", Quantity: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), resp.ReceivedQuantity)
+	assert.False(t, resp.Finished)
+	assert.Equal(t, int32(4), stock())
+
+	// 重复提交只入库一次，同一个单号不能用于不同的数量
+	resp, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R1", Quantity: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, "Purchase order receipt already recorded", resp.Feedback)
+	assert.Equal(t, int32(4), resp.ReceivedQuantity)
+	assert.Equal(t, int32(4), stock())
+	_, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R1", Quantity: 5})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 多发的书全部入库，累计达到采购数量后自动结束
+	resp, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R2", Quantity: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(11), resp.ReceivedQuantity)
+	assert.True(t, resp.Finished)
+	assert.Equal(t, int32(11), stock())
+
+	// 结束后不再接收新的到货，已经登记的到货仍然可以重试
+	_, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R3", Quantity: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R2", Quantity: 7})
+	assert.NoError(t, err)
+
+	// 少发时提前结束，不同采购单可以使用相同的单号
+	_, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 2, ReceiptId: "R1", Quantity: 3})
+	assert.NoError(t, err)
+	resp, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 2, ReceiptId: "R2", Close: true})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.ReceivedQuantity)
+	assert.True(t, resp.Finished)
+	assert.Equal(t, int32(14), stock())
+
+	// 旧的 finished 标记只把剩余的数量入库，重复标记不会重复入库
+	_, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 3, ReceiptId: "R1", Quantity: 2})
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = purchaseOrderServer.UpdatePurchaseOrder(ctx, &pb.UpdatePurchaseOrderRequest{Id: 3, Finished: true})
+		assert.NoError(t, err)
+	}
+	var purchaseOrder models.PurchaseOrder
+	db.Preload("Items").Preload("Receipts").First(&purchaseOrder, 3)
+	assert.Equal(t, int32(10), purchaseOrder.Items[0].ReceivedQuantity)
+	assert.True(t, purchaseOrder.Finished)
+	assert.Equal(t, 2, len(purchaseOrder.Receipts))
+	assert.Equal(t, int32(24), stock())
+
+	// 每次到货都记为入库
+	discrepancies, err := ReconcileStock(ctx, db)
+	assert.NoError(t, err)
+	assert.Empty(t, discrepancies)
+}
+
+func TestReceiveMultiItemPurchaseOrder(t *testing.T) {
+	db := setupTestDB(t)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	ctx := context.Background()
+
+	db.Create(&models.PurchaseOrder{Supplier: "Supplier", OrderDate: "2023-01-01", Items: []*models.PurchaseOrderItem{
+		{BookNo: "B001", Title: "Book 1", Publisher: "Publisher", Author: "Author", Quantity: 3},
+		{BookNo: "B002", Title: "Book 2", Publisher: "Publisher", Author: "Author", Quantity: 2},
+	}})
+
+	// 多行采购单必须指明到货的书
+	_, err := purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R1", Quantity: 3})
+	assert.Equal(t, "book_no", badRequestField(t, err))
+	_, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R1", BookNo: "B009", Quantity: 3})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// 同一个到货单可以包含多种书，各行全部到货后采购单结束
+	resp, err := purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R1", BookNo: "B001", Quantity: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.ReceivedQuantity)
+	assert.False(t, resp.Finished)
+	resp, err = purchaseOrderServer.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{Id: 1, ReceiptId: "R1", BookNo: "B002", Quantity: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.ReceivedQuantity)
+	assert.True(t, resp.Finished)
+
+	var books []models.Book
+	db.Order("book_no").Find(&books)
+	assert.Equal(t, 2, len(books))
+	assert.Equal(t, int32(3), books[0].StockQuantity)
+	assert.Equal(t, int32(2), books[1].StockQuantity)
+
+	// 书的信息只能在只有一行的采购单上修改
+	_, err = purchaseOrderServer.UpdatePurchaseOrder(ctx, &pb.UpdatePurchaseOrderRequest{Id: 1, Quantity: 5})
+	assert.Equal(t, "items", badRequestField(t, err))
+}
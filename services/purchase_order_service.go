@@ -3,24 +3,30 @@ package services
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PurchaseOrderServiceServer struct {
 	pb.UnimplementedPurchaseOrderServiceServer
 	db *gorm.DB
+
+	// SupplierPolicy 是生成采购单时默认的供应商选择策略，请求中没有指定时使用
+	SupplierPolicy string
 }
 
 // NewPurchaseOrderServiceServer 用于创建 PurchaseOrderServiceServer
 func NewPurchaseOrderServiceServer(db *gorm.DB) *PurchaseOrderServiceServer {
 	return &PurchaseOrderServiceServer{
-		db: db,
+		db:             db,
+		SupplierPolicy: SupplierPolicyCheapest,
 	}
 }
 
@@ -28,73 +34,51 @@ func NewPurchaseOrderServiceServer(db *gorm.DB) *PurchaseOrderServiceServer {
 func (s *PurchaseOrderServiceServer) CreatePurchaseOrder(ctx context.Context, req *pb.CreatePurchaseOrderRequest) (*pb.CreatePurchaseOrderResponse, error) {
 	// 验证用户输入
 	if req.GetBookNo() == "" {
-		return &pb.CreatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: "BookNo is required",
-		}, nil
+		return nil, invalidArgumentError("book_no", "BookNo is required")
 	}
 
 	if req.GetTitle() == "" {
-		return &pb.CreatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: "Title is required",
-		}, nil
+		return nil, invalidArgumentError("title", "Title is required")
 	}
 
 	if req.GetPublisher() == "" {
-		return &pb.CreatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: "Publisher is required",
-		}, nil
+		return nil, invalidArgumentError("publisher", "Publisher is required")
 	}
 
 	if req.GetSupplier() == "" {
-		return &pb.CreatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: "Supplier is required",
-		}, nil
+		return nil, invalidArgumentError("supplier", "Supplier is required")
 	}
 
 	if req.GetAuthor() == "" {
-		return &pb.CreatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: "Author is required",
-		}, nil
+		return nil, invalidArgumentError("author", "Author is required")
 	}
 
 	if req.GetQuantity() <= 0 {
-		return &pb.CreatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: "Quantity must be greater than 0",
-		}, nil
+		return nil, invalidArgumentError("quantity", "Quantity must be greater than 0")
 	}
 
 	if req.GetOrderDate() == "" {
-		return &pb.CreatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: "Order date is required",
-		}, nil
+		return nil, invalidArgumentError("order_date", "Order date is required")
 	}
 
-	// 构建新的 PurchaseOrder 对象
+	// 构建新的 PurchaseOrder 对象，手动创建的采购单只有一行
 	purchaseOrder := &models.PurchaseOrder{
-		BookNo:    req.GetBookNo(),
-		Title:     req.GetTitle(),
-		Publisher: req.GetPublisher(),
 		Supplier:  req.GetSupplier(),
-		Author:    req.GetAuthor(),
-		Quantity:  req.GetQuantity(),
 		OrderDate: req.GetOrderDate(),
+		Items: []*models.PurchaseOrderItem{{
+			BookNo:    req.GetBookNo(),
+			Title:     req.GetTitle(),
+			Publisher: req.GetPublisher(),
+			Author:    req.GetAuthor(),
+			Quantity:  req.GetQuantity(),
+		}},
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
 	// 写入数据库
 	if err := s.db.Create(&purchaseOrder).Error; err != nil {
-		return &pb.CreatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to create purchase order: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to create purchase order: %v", err)
 	}
 
 	// 返回成功的响应
@@ -106,39 +90,25 @@ func (s *PurchaseOrderServiceServer) CreatePurchaseOrder(ctx context.Context, re
 
 // GetPurchaseOrder 获取采购单
 func (s *PurchaseOrderServiceServer) GetPurchaseOrder(ctx context.Context, req *pb.GetPurchaseOrderRequest) (*pb.GetPurchaseOrderResponse, error) {
-	// 验证请求参数
-	if req.GetStart() < 0 || req.GetStop() < req.GetStart() {
-		return &pb.GetPurchaseOrderResponse{
-			Success:  false,
-			Feedback: "Invalid range: start must be >= 0 and stop must be >= start",
-		}, nil
+	// 构建查询
+	query, err := purchaseOrderQuery(s.db, req.GetBookNo(), req.GetSupplier(), req.Finished, req.GetCreatedAfter(), req.GetCreatedBefore())
+	if err != nil {
+		return nil, err
 	}
 
 	// 查询采购单
-	var purchaseOrders []*models.PurchaseOrder
-	if err := s.db.Offset(int(req.GetStart())).Limit(int(req.GetStop() - req.GetStart() + 1)).Find(&purchaseOrders).Error; err != nil {
-		return &pb.GetPurchaseOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query purchase orders: %v", err),
-		}, nil
+	scope := pageScope("purchase_orders", req.GetBookNo(), req.GetSupplier(), req.Finished, req.GetCreatedAfter(), req.GetCreatedBefore())
+	result, err := paginate(query, req, scope, func(purchaseOrder *models.PurchaseOrder) (time.Time, int32) {
+		return purchaseOrder.CreatedAt, purchaseOrder.ID
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// 构建响应
 	var pbPurchaseOrders []*pb.PurchaseOrder
-	for _, purchaseOrder := range purchaseOrders {
-		pbPurchaseOrder := &pb.PurchaseOrder{
-			Id:        int32(purchaseOrder.ID),
-			BookNo:    purchaseOrder.BookNo,
-			Title:     purchaseOrder.Title,
-			Publisher: purchaseOrder.Publisher,
-			Supplier:  purchaseOrder.Supplier,
-			Author:    purchaseOrder.Author,
-			Quantity:  purchaseOrder.Quantity,
-			OrderDate: purchaseOrder.OrderDate,
-			Finished:  purchaseOrder.Finished,
-		}
-
-		pbPurchaseOrders = append(pbPurchaseOrders, pbPurchaseOrder)
+	for _, purchaseOrder := range result.Items {
+		pbPurchaseOrders = append(pbPurchaseOrders, toPbPurchaseOrder(purchaseOrder))
 	}
 
 	// 返回响应
@@ -146,230 +116,223 @@ func (s *PurchaseOrderServiceServer) GetPurchaseOrder(ctx context.Context, req *
 		Success:        true,
 		Feedback:       "Purchase orders retrieved successfully",
 		PurchaseOrders: pbPurchaseOrders,
+		NextPageToken:  result.NextPageToken,
+		TotalCount:     result.TotalCount,
 	}, nil
 }
 
+// StreamPurchaseOrders 导出符合过滤条件的采购单，每条记录发送一个消息
+func (s *PurchaseOrderServiceServer) StreamPurchaseOrders(req *pb.StreamPurchaseOrdersRequest, stream pb.PurchaseOrderService_StreamPurchaseOrdersServer) error {
+	// 构建查询
+	query, err := purchaseOrderQuery(s.db, req.GetBookNo(), req.GetSupplier(), req.Finished, req.GetCreatedAfter(), req.GetCreatedBefore())
+	if err != nil {
+		return err
+	}
+
+	// 逐条发送采购单
+	return streamRecords(stream.Context(), query, func(purchaseOrder *models.PurchaseOrder) error {
+		return stream.Send(toPbPurchaseOrder(purchaseOrder))
+	})
+}
+
 // UpdatePurchaseOrder 更新采购单
 func (s *PurchaseOrderServiceServer) UpdatePurchaseOrder(ctx context.Context, req *pb.UpdatePurchaseOrderRequest) (*pb.UpdatePurchaseOrderResponse, error) {
-	var purchaseOrder models.PurchaseOrder
+	feedback := "Purchase order updated successfully"
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 查询采购单并加行锁，避免与登记到货同时修改
+		var purchaseOrder models.PurchaseOrder
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items", orderByID).First(&purchaseOrder, req.GetId()).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Error(codes.NotFound, "Purchase order not found")
+			}
+			return status.Errorf(codes.Internal, "failed to query purchase order: %v", err)
+		}
 
-	// 查询采购单
-	if err := s.db.First(&purchaseOrder, req.GetId()).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return &pb.UpdatePurchaseOrderResponse{
-				Success:  false,
-				Feedback: "Purchase order not found",
-			}, nil
+		// 书的信息保存在采购单的行中，只有一行的采购单可以通过这些字段修改
+		if err := updatePurchaseOrderItem(tx, &purchaseOrder, req); err != nil {
+			return err
 		}
-		return &pb.UpdatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query purchase order: %v", err),
-		}, nil
-	}
 
-	// 更新字段
-	if req.GetBookNo() != purchaseOrder.BookNo && req.GetBookNo() != "" {
-		// 这个字段不能更改
-		return &pb.UpdatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: "BookNo cannot be updated",
-		}, nil
-	}
-	if req.GetTitle() != "" {
-		purchaseOrder.Title = req.GetTitle()
-	}
-	if req.GetPublisher() != "" {
-		purchaseOrder.Publisher = req.GetPublisher()
-	}
-	if req.GetSupplier() != "" {
-		purchaseOrder.Supplier = req.GetSupplier()
-	}
-	if req.GetAuthor() != "" {
-		purchaseOrder.Author = req.GetAuthor()
-	}
-	if req.GetQuantity() != 0 {
-		purchaseOrder.Quantity = req.GetQuantity()
-	}
-	if req.GetOrderDate() != "" {
-		purchaseOrder.OrderDate = req.GetOrderDate()
-	}
-	if req.GetFinished() {
-		purchaseOrder.Finished = req.GetFinished()
+		// 更新字段
+		if req.GetSupplier() != "" {
+			purchaseOrder.Supplier = req.GetSupplier()
+		}
+		if req.GetOrderDate() != "" {
+			purchaseOrder.OrderDate = req.GetOrderDate()
+		}
+		purchaseOrder.UpdatedAt = time.Now()
+		if err := tx.Omit("Items").Save(&purchaseOrder).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to update purchase order: %v", err)
+		}
 
-		// 更新书籍库存数量
-		var book models.Book
-		if err := s.db.Where("book_no = ?", purchaseOrder.BookNo).First(&book).Error; err != nil {
-			if err == gorm.ErrRecordNotFound {
-				// 如果书籍不存在，创建书籍记录
-				book = models.Book{
-					BookNo:        purchaseOrder.BookNo,
-					Title:         purchaseOrder.Title,
-					PublisherName: purchaseOrder.Publisher,
-					Authors:       purchaseOrder.Author,
-					StockQuantity: purchaseOrder.Quantity,
-					CreatedAt:     time.Now(),
-					UpdatedAt:     time.Now(),
-				}
-				if err := s.db.Create(&book).Error; err != nil {
-					return &pb.UpdatePurchaseOrderResponse{
-						Success:  false,
-						Feedback: fmt.Sprintf("failed to create book: %v", err),
-					}, nil
-				}
-			} else {
-				return &pb.UpdatePurchaseOrderResponse{
-					Success:  false,
-					Feedback: fmt.Sprintf("failed to find book: %v", err),
-				}, nil
+		// 标记为完成时把每行剩余的数量登记为一次到货，已经完成的采购单不会重复入库
+		if !req.GetFinished() || purchaseOrder.Finished {
+			return nil
+		}
+		for _, item := range purchaseOrder.Items {
+			remaining := item.Quantity - item.ReceivedQuantity
+			if remaining < 0 {
+				remaining = 0
 			}
-		} else {
-			book.StockQuantity += purchaseOrder.Quantity
-			if err := s.db.Save(&book).Error; err != nil {
-				return &pb.UpdatePurchaseOrderResponse{
-					Success:  false,
-					Feedback: fmt.Sprintf("failed to update book stock quantity: %v", err),
-				}, nil
+			waitlistFeedback, err := receivePurchaseOrder(tx, &purchaseOrder, item, finishReceiptID, remaining, true, callerActor(ctx))
+			if err != nil {
+				return err
+			}
+			if waitlistFeedback != "" {
+				feedback = fmt.Sprintf("%s\n%s", feedback, waitlistFeedback)
 			}
 		}
-	}
-	purchaseOrder.UpdatedAt = time.Now()
-
-	// 保存更新
-	if err := s.db.Save(&purchaseOrder).Error; err != nil {
-		return &pb.UpdatePurchaseOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to update purchase order: %v", err),
-		}, nil
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
 	// 返回成功的响应
 	return &pb.UpdatePurchaseOrderResponse{
 		Success:  true,
-		Feedback: "Purchase order updated successfully",
+		Feedback: feedback,
 	}, nil
 }
 
-// DeletePurchaseOrder 删除采购单
+// DeletePurchaseOrder 删除采购单，已经登记过到货的采购单不能删除，由它处理的缺书登记重新变为未完成
 func (s *PurchaseOrderServiceServer) DeletePurchaseOrder(ctx context.Context, req *pb.DeletePurchaseOrderRequest) (*pb.DeletePurchaseOrderResponse, error) {
-	var purchaseOrder models.PurchaseOrder
-
-	// 查询采购单
-	if err := s.db.First(&purchaseOrder, req.GetId()).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return &pb.DeletePurchaseOrderResponse{
-				Success:  false,
-				Feedback: "Purchase order not found",
-			}, nil
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// 查询采购单并加行锁，避免与登记到货同时进行
+		var purchaseOrder models.PurchaseOrder
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&purchaseOrder, req.GetId()).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Error(codes.NotFound, "Purchase order not found")
+			}
+			return status.Errorf(codes.Internal, "failed to query purchase order: %v", err)
 		}
-		return &pb.DeletePurchaseOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query purchase order: %v", err),
-		}, nil
-	}
-
-	// 删除采购单
-	if err := s.db.Delete(&purchaseOrder).Error; err != nil {
-		return &pb.DeletePurchaseOrderResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to delete purchase order: %v", err),
-		}, nil
-	}
-
-	// 返回成功的响应
-	return &pb.DeletePurchaseOrderResponse{
-		Success:  true,
-		Feedback: "Purchase order deleted successfully",
-	}, nil
-}
 
-// GeneratePurchaseOrdersFromStockRequests 根据未完成的缺书记录生成采购单
-func (s *PurchaseOrderServiceServer) GeneratePurchaseOrdersFromStockRequests(ctx context.Context, req *pb.GeneratePurchaseOrdersRequest) (*pb.GeneratePurchaseOrdersResponse, error) {
-	// 查询所有 Finished=false 的缺书记录
-	var stockRequests []*models.StockRequest
-	if err := s.db.Where("finished = ?", false).Find(&stockRequests).Error; err != nil {
-		return &pb.GeneratePurchaseOrdersResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query stock requests: %v", err),
-		}, nil
-	}
-	var feedback string
-	// 生成采购单
-	for _, stockRequest := range stockRequests {
-		purchaseOrder := &models.PurchaseOrder{
-			BookNo:    stockRequest.BookNo,
-			Title:     stockRequest.Title,
-			Publisher: stockRequest.Publisher,
-			Supplier:  stockRequest.Supplier,
-			Author:    stockRequest.Author,
-			Quantity:  stockRequest.Quantity,
-			OrderDate: time.Now().Format("2006-01-02"),
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+		// 到货记录是库存变动的依据，不能随采购单一起删除
+		var receipts int64
+		if err := tx.Model(&models.PurchaseOrderReceipt{}).Where("purchase_order_id = ?", purchaseOrder.ID).Count(&receipts).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to query purchase order receipts: %v", err)
 		}
-
-		// 写入数据库
-		if err := s.db.Create(&purchaseOrder).Error; err != nil {
-			return &pb.GeneratePurchaseOrdersResponse{
-				Success:  false,
-				Feedback: fmt.Sprintf("Failed to create purchase order: %v", err),
-			}, nil
+		if receipts > 0 {
+			return status.Error(codes.FailedPrecondition, "Purchase order has received stock and cannot be deleted")
 		}
 
-		// 更新缺书记录的 Finished 字段
-		stockRequest.Finished = true
-		if err := s.db.Save(&stockRequest).Error; err != nil {
-			return &pb.GeneratePurchaseOrdersResponse{
-				Success:  false,
-				Feedback: fmt.Sprintf("Failed to update stock request: %v", err),
-			}, nil
+		// 由这个采购单处理的缺书登记重新等待生成采购单
+		if err := tx.Model(&models.StockRequest{}).
+			Where("purchase_order_id = ?", purchaseOrder.ID).
+			Updates(map[string]interface{}{
+				"finished":          false,
+				"purchase_order_id": nil,
+				"updated_at":        time.Now(),
+			}).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to reopen stock requests: %v", err)
 		}
 
-		// 发送电子邮件通想要买相应书的客户
-		feedback = s.generateFakeEmail(*stockRequest)
+		// 删除采购单和它的各行
+		if err := tx.Where("purchase_order_id = ?", purchaseOrder.ID).Delete(&models.PurchaseOrderItem{}).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to delete purchase order: %v", err)
+		}
+		if err := tx.Delete(&purchaseOrder).Error; err != nil {
+			return status.Errorf(codes.Internal, "failed to delete purchase order: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
 	// 返回成功的响应
-	return &pb.GeneratePurchaseOrdersResponse{
+	return &pb.DeletePurchaseOrderResponse{
 		Success:  true,
-		Feedback: fmt.Sprintf("Purchase orders generated successfully\n%s", feedback),
+		Feedback: "Purchase order deleted successfully",
 	}, nil
 }
 
-// generateFakeEmail 生成假电子邮件
-func (s *PurchaseOrderServiceServer) generateFakeEmail(stockRequest models.StockRequest) string {
-	// 创建目录
-	dir := ".\\fake_emails"
-	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-		fmt.Printf("Failed to create directory: %v\n", err)
-		return "Failed to create directory"
+// purchaseOrderQuery 按过滤条件构建采购单查询，为空的条件不过滤
+func purchaseOrderQuery(db *gorm.DB, bookNo, supplier string, finished *bool, createdAfter, createdBefore *timestamppb.Timestamp) (*gorm.DB, error) {
+	created, err := createdBetween(createdAfter, createdBefore)
+	if err != nil {
+		return nil, err
 	}
-
-	// 查找相关客户订单
-	var customerOrders []models.CustomerOrder
-	if err := s.db.Where("book_no = ?", stockRequest.BookNo).Find(&customerOrders).Error; err != nil {
-		fmt.Printf("Failed to query customer orders: %v\n", err)
-		return "Failed to query customer orders"
+	query := db.Scopes(created).Preload("Items", orderByID)
+	if bookNo != "" {
+		query = query.Where("id IN (?)", db.Model(&models.PurchaseOrderItem{}).Select("purchase_order_id").Where("book_no = ?", bookNo))
 	}
-
-	if len(customerOrders) == 0 {
-		return fmt.Sprintf("没有客户创建了书目%s的相关订单，无须发送邮件", stockRequest.Title)
+	if supplier != "" {
+		query = query.Where("supplier = ?", supplier)
 	}
+	if finished != nil {
+		query = query.Where("finished = ?", *finished)
+	}
+	return query, nil
+}
 
-	// 生成假电子邮件
-	for _, order := range customerOrders {
-		var customer models.Customer
-		if err := s.db.Where("online_id = ?", order.CustomerOnlineID).First(&customer).Error; err != nil {
-			fmt.Printf("Failed to query customer: %v\n", err)
-			continue
-		}
-
-		emailContent := fmt.Sprintf("客户%s您好，您要的%s书目已经上新", customer.Name, stockRequest.Title)
-		today := time.Now().Format("2006-01-02")
-		emailFile := filepath.Join(dir, fmt.Sprintf("email_%d_%s.txt", order.ID, today))
-		if err := os.WriteFile(emailFile, []byte(emailContent), 0644); err != nil {
-			fmt.Printf("Failed to write email file: %v\n", err)
-		}
+// toPbPurchaseOrder 把采购单转换为 pb.PurchaseOrder
+func toPbPurchaseOrder(purchaseOrder *models.PurchaseOrder) *pb.PurchaseOrder {
+	pbPurchaseOrder := &pb.PurchaseOrder{
+		Id:        int32(purchaseOrder.ID),
+		Supplier:  purchaseOrder.Supplier,
+		OrderDate: purchaseOrder.OrderDate,
+		Finished:  purchaseOrder.Finished,
+	}
+	if purchaseOrder.SupplierID != nil {
+		pbPurchaseOrder.SupplierId = *purchaseOrder.SupplierID
+	}
+	for _, item := range purchaseOrder.Items {
+		pbPurchaseOrder.Items = append(pbPurchaseOrder.Items, &pb.PurchaseOrderItem{
+			Id:               item.ID,
+			BookNo:           item.BookNo,
+			Title:            item.Title,
+			Publisher:        item.Publisher,
+			Author:           item.Author,
+			Quantity:         item.Quantity,
+			ReceivedQuantity: item.ReceivedQuantity,
+			UnitCost:         item.UnitCost,
+		})
+		pbPurchaseOrder.Quantity += item.Quantity
+		pbPurchaseOrder.ReceivedQuantity += item.ReceivedQuantity
 	}
+	if len(purchaseOrder.Items) == 1 {
+		item := purchaseOrder.Items[0]
+		pbPurchaseOrder.BookNo = item.BookNo
+		pbPurchaseOrder.Title = item.Title
+		pbPurchaseOrder.Publisher = item.Publisher
+		pbPurchaseOrder.Author = item.Author
+		pbPurchaseOrder.UnitCost = item.UnitCost
+	}
+	return pbPurchaseOrder
+}
 
-	return "已暂存电子邮件通知客户"
+// updatePurchaseOrderItem 用请求中书的信息更新只有一行的采购单，书号不能修改
+func updatePurchaseOrderItem(tx *gorm.DB, purchaseOrder *models.PurchaseOrder, req *pb.UpdatePurchaseOrderRequest) error {
+	if req.GetQuantity() < 0 {
+		return invalidArgumentError("quantity", "Quantity must be greater than 0")
+	}
+	if req.GetBookNo() == "" && req.GetTitle() == "" && req.GetPublisher() == "" && req.GetAuthor() == "" && req.GetQuantity() == 0 {
+		return nil
+	}
+	if len(purchaseOrder.Items) != 1 {
+		return invalidArgumentError("items", "Book fields can only be updated on purchase orders with one item")
+	}
+	item := purchaseOrder.Items[0]
+	if req.GetBookNo() != item.BookNo && req.GetBookNo() != "" {
+		// 这个字段不能更改
+		return invalidArgumentError("book_no", "BookNo cannot be updated")
+	}
+	if req.GetTitle() != "" {
+		item.Title = req.GetTitle()
+	}
+	if req.GetPublisher() != "" {
+		item.Publisher = req.GetPublisher()
+	}
+	if req.GetAuthor() != "" {
+		item.Author = req.GetAuthor()
+	}
+	if req.GetQuantity() != 0 {
+		item.Quantity = req.GetQuantity()
+	}
+	item.UpdatedAt = time.Now()
+	if err := tx.Save(item).Error; err != nil {
+		return status.Errorf(codes.Internal, "failed to update purchase order item: %v", err)
+	}
+	return nil
 }
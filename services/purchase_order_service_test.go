@@ -9,18 +9,13 @@ import (
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/driver/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
-func setupTestDB() *gorm.DB {
-	db, _ := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	models.AutoMigrate(db)
-	return db
-}
-
 func TestCreateUpdateDeletePurchaseOrder(t *testing.T) {
-	db := setupTestDB()
+	db := setupTestDB(t)
 	bookServer := NewBookServiceServer(db)
 	stockRequestServer := NewStockRequestServiceServer(db)
 	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
@@ -103,27 +98,33 @@ func TestCreateUpdateDeletePurchaseOrder(t *testing.T) {
 		var book models.Book
 		err := db.Where("book_no = ?", fmt.Sprintf("B%03d", i)).First(&book).Error
 		assert.NoError(t, err)
-		expectedQuantity := int32(50 + 7*10) // 初始库存 + 每本书的采购数量总和
+		// 初始库存 + 每本书的采购数量总和，B001 对应 6 张采购单，B002、B003 各对应 7 张
+		orders := int32(7)
+		if i == 1 {
+			orders = 6
+		}
+		expectedQuantity := 50 + orders*10
 		assert.Equal(t, expectedQuantity, book.StockQuantity)
 	}
 
-	// 删除所有采购记录
+	// 数量不能为负数
+	_, err := purchaseOrderServer.UpdatePurchaseOrder(context.Background(), &pb.UpdatePurchaseOrderRequest{Id: 1, Quantity: -5})
+	assert.Equal(t, "quantity", badRequestField(t, err))
+
+	// 已经到货的采购记录不能删除
 	for i := 1; i <= 20; i++ {
 		req := &pb.DeletePurchaseOrderRequest{
 			Id: int32(i),
 		}
-		resp, err := purchaseOrderServer.DeletePurchaseOrder(context.Background(), req)
-		assert.NoError(t, err)
-		assert.True(t, resp.Success)
-		assert.Equal(t, "Purchase order deleted successfully", resp.Feedback)
+		_, err := purchaseOrderServer.DeletePurchaseOrder(context.Background(), req)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 	}
 
-	// 验证所有采购记录是否删除成功
+	// 验证采购记录没有被删除
 	for i := 1; i <= 20; i++ {
 		var purchaseOrder models.PurchaseOrder
 		err := db.First(&purchaseOrder, uint32(i)).Error
-		assert.Error(t, err)
-		assert.Equal(t, gorm.ErrRecordNotFound, err)
+		assert.NoError(t, err)
 	}
 
 	// 添加几个缺书登记
@@ -143,6 +144,23 @@ func TestCreateUpdateDeletePurchaseOrder(t *testing.T) {
 		assert.Equal(t, "Stock request created successfully", createStockRequestResp.Feedback)
 	}
 
+	// 添加可以供应这些书的供应商
+	supplierServer := NewSupplierServiceServer(db)
+	var supplyBooks []*pb.SupplyBook
+	for i := 1; i <= 3; i++ {
+		supplyBooks = append(supplyBooks, &pb.SupplyBook{
+			BookNo:   fmt.Sprintf("B%03d", i),
+			Title:    fmt.Sprintf("Book Title %d", i),
+			Price:    60,
+			Quantity: 20,
+		})
+	}
+	_, err = supplierServer.CreateSupplier(context.Background(), &pb.CreateSupplierRequest{
+		Name:        "Test Supplier",
+		SupplyBooks: supplyBooks,
+	})
+	assert.NoError(t, err)
+
 	// 生成采购单
 	generatePurchaseOrdersReq := &pb.GeneratePurchaseOrdersRequest{}
 	generatePurchaseOrdersResp, err := purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(context.Background(), generatePurchaseOrdersReq)
@@ -150,16 +168,20 @@ func TestCreateUpdateDeletePurchaseOrder(t *testing.T) {
 	assert.True(t, generatePurchaseOrdersResp.Success)
 	assert.Equal(t, "Purchase orders generated successfully", generatePurchaseOrdersResp.Feedback)
 
-	// 查询并验证采购单
-	for i := 1; i <= 3; i++ {
-		var purchaseOrder models.PurchaseOrder
-		err := db.Where("book_no = ?", fmt.Sprintf("B%03d", i)).First(&purchaseOrder).Error
-		assert.NoError(t, err)
-		assert.Equal(t, fmt.Sprintf("Book Title %d", i), purchaseOrder.Title)
-		assert.Equal(t, "Test Publisher", purchaseOrder.Publisher)
-		assert.Equal(t, "Test Supplier", purchaseOrder.Supplier)
-		assert.Equal(t, "Test Author", purchaseOrder.Author)
-		assert.Equal(t, int32(10), purchaseOrder.Quantity)
+	// 同一个供应商的三本书合并为一个采购单
+	assert.Equal(t, 1, len(generatePurchaseOrdersResp.PurchaseOrders))
+	var purchaseOrder models.PurchaseOrder
+	err = db.Preload("Items", orderByID).First(&purchaseOrder, generatePurchaseOrdersResp.PurchaseOrders[0].Id).Error
+	assert.NoError(t, err)
+	assert.Equal(t, "Test Supplier", purchaseOrder.Supplier)
+	assert.Equal(t, 3, len(purchaseOrder.Items))
+	for i, item := range purchaseOrder.Items {
+		assert.Equal(t, fmt.Sprintf("B%03d", i+1), item.BookNo)
+		assert.Equal(t, fmt.Sprintf("Book Title %d", i+1), item.Title)
+		assert.Equal(t, "Test Publisher", item.Publisher)
+		assert.Equal(t, "Test Author", item.Author)
+		assert.Equal(t, int32(10), item.Quantity)
+		assert.Equal(t, int32(60), item.UnitCost)
 	}
 
 	// 验证缺书登记的 Finished 字段是否更新
@@ -169,4 +191,19 @@ func TestCreateUpdateDeletePurchaseOrder(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, stockRequest.Finished)
 	}
+
+	// 删除还没有到货的采购单，缺书登记重新变为未完成
+	deleteResp, err := purchaseOrderServer.DeletePurchaseOrder(context.Background(), &pb.DeletePurchaseOrderRequest{Id: purchaseOrder.ID})
+	assert.NoError(t, err)
+	assert.True(t, deleteResp.Success)
+	assert.Equal(t, "Purchase order deleted successfully", deleteResp.Feedback)
+	err = db.First(&models.PurchaseOrder{}, purchaseOrder.ID).Error
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+	for i := 1; i <= 3; i++ {
+		var stockRequest models.StockRequest
+		err := db.Where("book_no = ?", fmt.Sprintf("B%03d", i)).First(&stockRequest).Error
+		assert.NoError(t, err)
+		assert.False(t, stockRequest.Finished)
+		assert.Nil(t, stockRequest.PurchaseOrderID)
+	}
 }
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoldenStain/goDB/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateReorderStockRequests 为可以下单的数量低于补货点的书创建缺书登记，返回创建的数量。
+// 已经有未完成的缺书登记或者未完成的采购单的书不会重复创建，等待它们到货
+func CreateReorderStockRequests(ctx context.Context, db *gorm.DB) (int, error) {
+	var ids []int32
+	err := db.WithContext(ctx).Model(&models.Book{}).
+		Where("reorder_point > 0 AND stock_quantity - reserved_quantity < reorder_point").
+		Order("id").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, fmt.Errorf("无法查询需要补货的书籍: %w", err)
+	}
+
+	created := 0
+	for _, id := range ids {
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// 锁定书籍，多个服务器实例同时补货时只创建一次
+			var book models.Book
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&book, id).Error; err != nil {
+				return err
+			}
+			// 查询之后库存或补货点已经改变
+			if book.ReorderPoint <= 0 || book.AvailableQuantity() >= book.ReorderPoint {
+				return nil
+			}
+			pending, err := hasPendingReplenishment(tx, book.BookNo)
+			if err != nil || pending {
+				return err
+			}
+
+			quantity := book.ReorderQuantity
+			if quantity <= 0 {
+				quantity = book.ReorderPoint - book.AvailableQuantity()
+			}
+			if _, err := createStockRequest(tx, &book, quantity, nil); err != nil {
+				return err
+			}
+			created++
+			return nil
+		})
+		if err != nil {
+			return created, fmt.Errorf("无法为书籍 %d 创建补货的缺书登记: %w", id, err)
+		}
+	}
+	return created, nil
+}
+
+// hasPendingReplenishment 判断书籍是否已经有未完成的缺书登记或者未完成的采购单
+func hasPendingReplenishment(tx *gorm.DB, bookNo string) (bool, error) {
+	var count int64
+	if err := tx.Model(&models.StockRequest{}).Where("book_no = ? AND finished = ?", bookNo, false).Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return true, nil
+	}
+	err := tx.Model(&models.PurchaseOrderItem{}).
+		Joins("JOIN purchase_orders ON purchase_orders.id = purchase_order_items.purchase_order_id").
+		Where("purchase_order_items.book_no = ? AND purchase_orders.finished = ?", bookNo, false).
+		Count(&count).Error
+	return count > 0, err
+}
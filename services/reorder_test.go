@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateReorderStockRequests(t *testing.T) {
+	db := setupTestDB(t)
+	bookServer := NewBookServiceServer(db)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	ctx := context.Background()
+
+	// 补货点不能为负数
+	_, err := bookServer.CreateBook(ctx, &pb.CreateBookRequest{BookNo: "B000", Title: "Book 0", PublisherName: "Publisher", Price: 10, StockQuantity: 1, ReorderPoint: -1})
+	assert.Equal(t, "reorder_point", badRequestField(t, err))
+
+	// B001 低于补货点，B002 没有设置补货点，B003 按差额补货
+	for _, req := range []*pb.CreateBookRequest{
+		{BookNo: "B001", Title: "Book 1", PublisherName: "Publisher", Price: 10, StockQuantity: 3, ReorderPoint: 5, ReorderQuantity: 20},
+		{BookNo: "B002", Title: "Book 2", PublisherName: "Publisher", Price: 10, StockQuantity: 1},
+		{BookNo: "B003", Title: "Book 3", PublisherName: "Publisher", Price: 10, StockQuantity: 8, ReorderPoint: 5},
+	} {
+		_, err := bookServer.CreateBook(ctx, req)
+		assert.NoError(t, err)
+	}
+	// 被预留的书不算可以下单的数量
+	db.Model(&models.Book{}).Where("book_no = ?", "B003").Update("reserved_quantity", 6)
+
+	created, err := CreateReorderStockRequests(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, created)
+	var stockRequests []models.StockRequest
+	db.Order("book_no").Find(&stockRequests)
+	assert.Equal(t, 2, len(stockRequests))
+	assert.Equal(t, "B001", stockRequests[0].BookNo)
+	assert.Equal(t, int32(20), stockRequests[0].Quantity)
+	assert.Equal(t, "B003", stockRequests[1].BookNo)
+	assert.Equal(t, int32(3), stockRequests[1].Quantity)
+
+	// 已经有未完成的缺书登记时不重复创建
+	created, err = CreateReorderStockRequests(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, created)
+
+	// 生成采购单后等待到货，采购单未完成时也不重复创建
+	supplier := &models.Supplier{Name: "Supplier"}
+	db.Create(supplier)
+	db.Create(&models.SupplyBook{BookNo: "B001", Title: "Book 1", SupplierID: supplier.ID, Price: 5, Quantity: 100})
+	db.Create(&models.SupplyBook{BookNo: "B003", Title: "Book 3", SupplierID: supplier.ID, Price: 5, Quantity: 100})
+	resp, err := purchaseOrderServer.GeneratePurchaseOrdersFromStockRequests(ctx, &pb.GeneratePurchaseOrdersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.PurchaseOrders, 1)
+	created, err = CreateReorderStockRequests(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, created)
+
+	// 到货后库存恢复，不再需要补货
+	_, err = purchaseOrderServer.UpdatePurchaseOrder(ctx, &pb.UpdatePurchaseOrderRequest{Id: resp.PurchaseOrders[0].Id, Finished: true})
+	assert.NoError(t, err)
+	created, err = CreateReorderStockRequests(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, created)
+
+	// 修改补货点后再次低于补货点，填写 0 时停止自动补货
+	reorderPoint := int32(100)
+	_, err = bookServer.UpdateBook(ctx, &pb.UpdateBookRequest{BookNo: "B001", ReorderPoint: &reorderPoint})
+	assert.NoError(t, err)
+	reorderPoint = 0
+	_, err = bookServer.UpdateBook(ctx, &pb.UpdateBookRequest{BookNo: "B003", ReorderPoint: &reorderPoint})
+	assert.NoError(t, err)
+	created, err = CreateReorderStockRequests(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, created)
+	getResp, err := bookServer.GetBook(ctx, &pb.GetBookRequest{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(100), getResp.Books[0].ReorderPoint)
+	assert.Equal(t, int32(20), getResp.Books[0].ReorderQuantity)
+}
@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 库存预留的默认保留时间和客户可以请求的最长时间
+const (
+	defaultReservationTTL    = 15 * time.Minute
+	defaultMaxReservationTTL = time.Hour
+)
+
+// 每次最多释放的过期预留数
+const expiredReservationBatchSize = 100
+
+var errReservationNotFound = status.Error(codes.NotFound, "Reservation not found")
+
+// lockActiveReservation 在事务中查询预留及其明细并加行锁，预留已经确认、释放或过期时返回 FailedPrecondition。
+// 调用者是客户时只能操作自己的预留。
+func lockActiveReservation(ctx context.Context, tx *gorm.DB, id int32) (*models.StockReservation, error) {
+	var reservation models.StockReservation
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items", orderByID).First(&reservation, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errReservationNotFound
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to query reservation: %v", err)
+	}
+	if onlineID, ok := customerScope(ctx); ok && reservation.CustomerOnlineID != onlineID {
+		return nil, status.Error(codes.PermissionDenied, "Customers can only access their own reservations")
+	}
+	if reservation.Status != models.ReservationActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "Reservation is already %s", reservation.Status)
+	}
+	return &reservation, nil
+}
+
+// releaseReservation 在事务中把预留的数量还给书籍，并把预留变更为 releasedStatus
+func releaseReservation(tx *gorm.DB, reservation *models.StockReservation, releasedStatus string) error {
+	for _, item := range reservation.Items {
+		// 书籍已被删除时无须恢复
+		if err := tx.Model(&models.Book{}).
+			Where("book_no = ? AND reserved_quantity >= ?", item.BookNo, item.Quantity).
+			Update("reserved_quantity", gorm.Expr("reserved_quantity - ?", item.Quantity)).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to release reserved stock: %v", err)
+		}
+	}
+
+	// 带条件更新，防止同一个预留被释放两次
+	result := tx.Model(&models.StockReservation{}).
+		Where("id = ? AND status = ?", reservation.ID, models.ReservationActive).
+		Updates(map[string]interface{}{"status": releasedStatus, "updated_at": time.Now()})
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "Failed to update reservation: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return status.Error(codes.FailedPrecondition, "Reservation was changed by another request")
+	}
+	reservation.Status = releasedStatus
+	return nil
+}
+
+// ReleaseExpiredReservations 释放一批在 now 之前过期、仍然有效的预留，返回释放的数量
+func ReleaseExpiredReservations(ctx context.Context, db *gorm.DB, now time.Time) (int, error) {
+	var ids []int32
+	err := db.WithContext(ctx).Model(&models.StockReservation{}).
+		Where("status = ? AND expires_at <= ?", models.ReservationActive, now).
+		Order("id").
+		Limit(expiredReservationBatchSize).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, fmt.Errorf("无法查询过期的预留: %w", err)
+	}
+
+	released := 0
+	for _, id := range ids {
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var reservation models.StockReservation
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items").First(&reservation, id).Error; err != nil {
+				return err
+			}
+			// 查询之后已经被确认或释放
+			if reservation.Status != models.ReservationActive {
+				return nil
+			}
+			if err := releaseReservation(tx, &reservation, models.ReservationExpired); err != nil {
+				return err
+			}
+			released++
+			return nil
+		})
+		if err != nil {
+			return released, fmt.Errorf("无法释放预留 %d: %w", id, err)
+		}
+	}
+	return released, nil
+}
+
+// ReserveStock 为客户预留购物车中的书，预留的数量在确认、释放或过期之前不能被其他订单使用
+func (s *BookServiceServer) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.ReserveStockResponse, error) {
+	// 验证用户输入
+	if req.GetCustomerOnlineId() == "" {
+		return nil, invalidArgumentError("customer_online_id", "Customer online ID is required")
+	}
+
+	// 客户只能为自己预留
+	if onlineID, ok := customerScope(ctx); ok && req.GetCustomerOnlineId() != onlineID {
+		return nil, status.Error(codes.PermissionDenied, "Customers can only reserve stock for themselves")
+	}
+
+	if len(req.GetItems()) == 0 {
+		return nil, invalidArgumentError("items", "At least one item is required")
+	}
+
+	// 验证每一行，并按书号汇总数量
+	bookCounts := make(map[string]int32)
+	for i, item := range req.GetItems() {
+		if item.GetBookNo() == "" {
+			return nil, invalidArgumentError(fmt.Sprintf("items[%d].book_no", i), "Book No is required")
+		}
+		if item.GetQuantity() <= 0 {
+			return nil, invalidArgumentError(fmt.Sprintf("items[%d].quantity", i), "Quantity must be greater than 0")
+		}
+		bookCounts[item.GetBookNo()] += item.GetQuantity()
+	}
+
+	ttl := s.ReservationTTL
+	if req.GetTtlSeconds() != 0 {
+		ttl = time.Duration(req.GetTtlSeconds()) * time.Second
+	}
+	if req.GetTtlSeconds() < 0 || ttl > s.MaxReservationTTL {
+		return nil, invalidArgumentError("ttl_seconds", fmt.Sprintf("TTL seconds must be between 0 and %d", int(s.MaxReservationTTL/time.Second)))
+	}
+
+	// 按书号排序加锁，避免多个预留互相等待造成死锁
+	bookNos := make([]string, 0, len(bookCounts))
+	for bookNo := range bookCounts {
+		bookNos = append(bookNos, bookNo)
+	}
+	sort.Strings(bookNos)
+
+	now := time.Now()
+	reservation := &models.StockReservation{
+		CustomerOnlineID: req.GetCustomerOnlineId(),
+		Status:           models.ReservationActive,
+		ExpiresAt:        now.Add(ttl),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("online_id = ?", req.GetCustomerOnlineId()).First(&models.Customer{}).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errCustomerNotFound
+			}
+			return status.Errorf(codes.Internal, "Failed to query customer: %v", err)
+		}
+
+		for _, bookNo := range bookNos {
+			var book models.Book
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("book_no = ?", bookNo).First(&book).Error; err != nil {
+				if err == gorm.ErrRecordNotFound {
+					return status.Errorf(codes.NotFound, "Book %s not found", bookNo)
+				}
+				return status.Errorf(codes.Internal, "Failed to query book: %v", err)
+			}
+
+			// 带条件更新，保证预留的数量不超过没有被预留的库存
+			result := tx.Model(&models.Book{}).
+				Where("id = ? AND stock_quantity - reserved_quantity >= ?", book.ID, bookCounts[bookNo]).
+				Update("reserved_quantity", gorm.Expr("reserved_quantity + ?", bookCounts[bookNo]))
+			if result.Error != nil {
+				return status.Errorf(codes.Internal, "Failed to reserve stock: %v", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return status.Errorf(codes.FailedPrecondition, "Insufficient stock for book %s", bookNo)
+			}
+
+			// 按当前的书价保存总价，确认时按这个价格扣款
+			reservation.Items = append(reservation.Items, &models.StockReservationItem{
+				BookNo:    bookNo,
+				Quantity:  bookCounts[bookNo],
+				Price:     book.Price * bookCounts[bookNo],
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+		}
+
+		if err := tx.Create(reservation).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to create reservation: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	items := make([]*pb.ReservationItem, 0, len(reservation.Items))
+	for _, item := range reservation.Items {
+		items = append(items, &pb.ReservationItem{
+			BookNo:   item.BookNo,
+			Quantity: item.Quantity,
+			Price:    item.Price,
+		})
+	}
+	return &pb.ReserveStockResponse{
+		Success:       true,
+		Feedback:      "Stock reserved successfully",
+		ReservationId: reservation.ID,
+		ExpiresAt:     timestamppb.New(reservation.ExpiresAt),
+		Items:         items,
+	}, nil
+}
+
+// ConfirmReservation 把有效的预留转为已付款的客户订单，按预留时的价格扣款
+func (s *BookServiceServer) ConfirmReservation(ctx context.Context, req *pb.ConfirmReservationRequest) (*pb.ConfirmReservationResponse, error) {
+	// 验证用户输入
+	if req.GetReservationId() <= 0 {
+		return nil, invalidArgumentError("reservation_id", "Reservation ID is required")
+	}
+
+	if req.GetOrderDate() == "" {
+		return nil, invalidArgumentError("order_date", "Order date is required")
+	}
+
+	if req.GetAddress() == "" {
+		return nil, invalidArgumentError("address", "Address is required")
+	}
+
+	// 过期的预留在事务中释放，释放需要提交，事务结束后再返回错误
+	expired := false
+	var order *models.CustomerOrder
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		reservation, err := lockActiveReservation(ctx, tx, req.GetReservationId())
+		if err != nil {
+			return err
+		}
+		if !reservation.ExpiresAt.After(time.Now()) {
+			expired = true
+			return releaseReservation(tx, reservation, models.ReservationExpired)
+		}
+
+		var customer models.Customer
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("online_id = ?", reservation.CustomerOnlineID).First(&customer).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return errCustomerNotFound
+			}
+			return status.Errorf(codes.Internal, "Failed to query customer: %v", err)
+		}
+
+		// 先还回预留的数量，再按订单扣除库存
+		if err := releaseReservation(tx, reservation, models.ReservationConfirmed); err != nil {
+			return err
+		}
+		order = &models.CustomerOrder{
+			OrderDate:        req.GetOrderDate(),
+			CustomerOnlineID: customer.OnlineID,
+			Address:          req.GetAddress(),
+		}
+		for _, item := range reservation.Items {
+			order.Items = append(order.Items, &models.CustomerOrderItem{
+				BookNo:    item.BookNo,
+				BookCount: item.Quantity,
+				Price:     item.Price,
+			})
+		}
+		if err := placePaidOrder(tx, &customer, order, callerActor(ctx)); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.StockReservation{}).Where("id = ?", reservation.ID).Update("customer_order_id", order.ID).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to update reservation: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if expired {
+		return nil, status.Error(codes.FailedPrecondition, "Reservation has expired")
+	}
+
+	return &pb.ConfirmReservationResponse{
+		Success:  true,
+		Feedback: "Reservation confirmed successfully",
+		OrderId:  order.ID,
+	}, nil
+}
+
+// ReleaseReservation 释放有效的预留，预留的数量重新可以被其他订单使用
+func (s *BookServiceServer) ReleaseReservation(ctx context.Context, req *pb.ReleaseReservationRequest) (*pb.ReleaseReservationResponse, error) {
+	// 验证用户输入
+	if req.GetReservationId() <= 0 {
+		return nil, invalidArgumentError("reservation_id", "Reservation ID is required")
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		reservation, err := lockActiveReservation(ctx, tx, req.GetReservationId())
+		if err != nil {
+			return err
+		}
+		return releaseReservation(tx, reservation, models.ReservationReleased)
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ReleaseReservationResponse{
+		Success:  true,
+		Feedback: "Reservation released successfully",
+	}, nil
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStockReservation(t *testing.T) {
+	db := setupTestDB(t)
+	bookServer := NewBookServiceServer(db)
+	customerOrderServer := NewCustomerOrderServiceServer(db)
+
+	db.Create(&models.Customer{OnlineID: "customer1", Name: "Customer 1", Address: "Address 1", AccountBalance: 1000, CreditLevel: 1})
+	db.Create(&models.Customer{OnlineID: "customer2", Name: "Customer 2", Address: "Address 2", AccountBalance: 1000, CreditLevel: 1})
+	db.Create(&models.Book{BookNo: "B001", Title: "Book 1", PublisherName: "Publisher", Authors: "Author", Price: 100, StockQuantity: 5})
+	db.Create(&models.Book{BookNo: "B002", Title: "Book 2", PublisherName: "Publisher", Authors: "Author", Price: 50, StockQuantity: 2})
+	ctx := callerContext("customer1", RoleCustomer)
+
+	// 客户只能为自己预留，保留时间不能超过最长时间
+	_, err := bookServer.ReserveStock(ctx, &pb.ReserveStockRequest{CustomerOnlineId: "customer2", Items: []*pb.ReservationItem{{BookNo: "B001", Quantity: 1}}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = bookServer.ReserveStock(ctx, &pb.ReserveStockRequest{CustomerOnlineId: "customer1", Items: []*pb.ReservationItem{{BookNo: "B001", Quantity: 1}}, TtlSeconds: 7200})
+	assert.Equal(t, "ttl_seconds", badRequestField(t, err))
+	_, err = bookServer.ReserveStock(ctx, &pb.ReserveStockRequest{CustomerOnlineId: "customer1", Items: []*pb.ReservationItem{{BookNo: "B001", Quantity: 0}}})
+	assert.Equal(t, "items[0].quantity", badRequestField(t, err))
+
+	// 同一本书的多行合并预留，按当前书价保存总价
+	resp, err := bookServer.ReserveStock(ctx, &pb.ReserveStockRequest{
+		CustomerOnlineId: "customer1",
+		Items: []*pb.ReservationItem{
+			{BookNo: "B001", Quantity: 2},
+			{BookNo: "B002", Quantity: 2},
+			{BookNo: "B001", Quantity: 1},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resp.Items))
+	assert.Equal(t, int32(3), resp.Items[0].Quantity)
+	assert.Equal(t, int32(300), resp.Items[0].Price)
+	assert.WithinDuration(t, time.Now().Add(defaultReservationTTL), resp.ExpiresAt.AsTime(), time.Minute)
+	reservationID := resp.ReservationId
+
+	// 预留的数量不能被其他客户购买或预留
+	bookResp, err := bookServer.GetBook(context.Background(), &pb.GetBookRequest{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, "B001", bookResp.Books[0].BookNo)
+	assert.Equal(t, int32(5), bookResp.Books[0].StockQuantity)
+	assert.Equal(t, int32(3), bookResp.Books[0].ReservedQuantity)
+	assert.Equal(t, int32(2), bookResp.Books[0].AvailableQuantity)
+	_, err = bookServer.ReserveStock(context.Background(), &pb.ReserveStockRequest{CustomerOnlineId: "customer2", Items: []*pb.ReservationItem{{BookNo: "B002", Quantity: 1}}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = customerOrderServer.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate: "2023-01-01", CustomerOnlineId: "customer2", Address: "Address 2",
+		Items: []*pb.CustomerOrderItem{{BookNo: "B001", BookCount: 3, Price: 300}},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 其他客户不能确认或释放
+	_, err = bookServer.ReleaseReservation(callerContext("customer2", RoleCustomer), &pb.ReleaseReservationRequest{ReservationId: reservationID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// 确认后按预留的价格和客户的折扣扣款，预留的数量转为扣除库存
+	confirmResp, err := bookServer.ConfirmReservation(ctx, &pb.ConfirmReservationRequest{ReservationId: reservationID, OrderDate: "2023-01-02", Address: "Reserved Address"})
+	assert.NoError(t, err)
+	var order models.CustomerOrder
+	assert.NoError(t, db.Preload("Items").First(&order, confirmResp.OrderId).Error)
+	assert.Equal(t, models.OrderStatusPaid, order.Status)
+	assert.Equal(t, int32(400), order.Price)
+	assert.Equal(t, int32(360), order.PaidAmount)
+	assert.Equal(t, 2, len(order.Items))
+	var book models.Book
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(2), book.StockQuantity)
+	assert.Equal(t, int32(0), book.ReservedQuantity)
+	var reservation models.StockReservation
+	db.First(&reservation, reservationID)
+	assert.Equal(t, models.ReservationConfirmed, reservation.Status)
+	assert.Equal(t, confirmResp.OrderId, *reservation.CustomerOrderID)
+
+	// 已经确认的预留不能再次确认或释放
+	_, err = bookServer.ConfirmReservation(ctx, &pb.ConfirmReservationRequest{ReservationId: reservationID, OrderDate: "2023-01-02", Address: "Reserved Address"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = bookServer.ReleaseReservation(ctx, &pb.ReleaseReservationRequest{ReservationId: reservationID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 释放后数量重新可用
+	resp, err = bookServer.ReserveStock(ctx, &pb.ReserveStockRequest{CustomerOnlineId: "customer1", Items: []*pb.ReservationItem{{BookNo: "B001", Quantity: 2}}})
+	assert.NoError(t, err)
+	_, err = bookServer.ReleaseReservation(ctx, &pb.ReleaseReservationRequest{ReservationId: resp.ReservationId})
+	assert.NoError(t, err)
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(0), book.ReservedQuantity)
+
+	// 库存不能改到低于预留的数量
+	resp, err = bookServer.ReserveStock(ctx, &pb.ReserveStockRequest{CustomerOnlineId: "customer1", Items: []*pb.ReservationItem{{BookNo: "B001", Quantity: 2}}, TtlSeconds: 60})
+	assert.NoError(t, err)
+	_, err = bookServer.UpdateBook(context.Background(), &pb.UpdateBookRequest{BookNo: "B001", StockQuantity: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 过期的预留由后台释放，不能再确认
+	released, err := ReleaseExpiredReservations(context.Background(), db, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, released)
+	released, err = ReleaseExpiredReservations(context.Background(), db, time.Now().Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, released)
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(0), book.ReservedQuantity)
+	var expired models.StockReservation
+	db.First(&expired, resp.ReservationId)
+	assert.Equal(t, models.ReservationExpired, expired.Status)
+	_, err = bookServer.ConfirmReservation(ctx, &pb.ConfirmReservationRequest{ReservationId: resp.ReservationId, OrderDate: "2023-01-03", Address: "Reserved Address"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 确认时已经过期但还没有被后台释放的预留在确认时释放
+	resp, err = bookServer.ReserveStock(ctx, &pb.ReserveStockRequest{CustomerOnlineId: "customer1", Items: []*pb.ReservationItem{{BookNo: "B001", Quantity: 1}}})
+	assert.NoError(t, err)
+	db.Model(&models.StockReservation{}).Where("id = ?", resp.ReservationId).Update("expires_at", time.Now().Add(-time.Second))
+	_, err = bookServer.ConfirmReservation(ctx, &pb.ConfirmReservationRequest{ReservationId: resp.ReservationId, OrderDate: "2023-01-03", Address: "Reserved Address"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	var lapsed models.StockReservation
+	db.First(&lapsed, resp.ReservationId)
+	assert.Equal(t, models.ReservationExpired, lapsed.Status)
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(0), book.ReservedQuantity)
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type SeriesServiceServer struct {
+	pb.UnimplementedSeriesServiceServer
+	db *gorm.DB
+}
+
+// NewSeriesServiceServer 用于创建 SeriesServiceServer
+func NewSeriesServiceServer(db *gorm.DB) *SeriesServiceServer {
+	return &SeriesServiceServer{
+		db: db,
+	}
+}
+
+// CreateSeries 创建丛书
+func (s *SeriesServiceServer) CreateSeries(ctx context.Context, req *pb.CreateSeriesRequest) (*pb.CreateSeriesResponse, error) {
+	// 验证用户输入
+	if req.GetName() == "" {
+		return nil, invalidArgumentError("name", "Name is required")
+	}
+
+	// 构建新的 Series 对象
+	series := &models.Series{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// 写入数据库
+	if err := s.db.Create(&series).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "Series already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create series: %v", err)
+	}
+
+	// 返回成功的响应
+	return &pb.CreateSeriesResponse{
+		Success:  true,
+		Feedback: "Series created successfully",
+		Id:       series.ID,
+	}, nil
+}
+
+// GetSeries 获取丛书
+func (s *SeriesServiceServer) GetSeries(ctx context.Context, req *pb.GetSeriesRequest) (*pb.GetSeriesResponse, error) {
+	// 查询丛书
+	result, err := paginate(s.db, req, pageScope("series"), func(series *models.Series) (time.Time, int32) {
+		return series.CreatedAt, series.ID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建响应
+	var pbSeries []*pb.Series
+	for _, series := range result.Items {
+		pbSeries = append(pbSeries, &pb.Series{
+			Id:          series.ID,
+			Name:        series.Name,
+			Description: series.Description,
+			CreatedAt:   timestamppb.New(series.CreatedAt),
+			UpdatedAt:   timestamppb.New(series.UpdatedAt),
+		})
+	}
+
+	// 返回响应
+	return &pb.GetSeriesResponse{
+		Success:       true,
+		Feedback:      "Series retrieved successfully",
+		Series:        pbSeries,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    result.TotalCount,
+	}, nil
+}
+
+// UpdateSeries 更新丛书信息
+func (s *SeriesServiceServer) UpdateSeries(ctx context.Context, req *pb.UpdateSeriesRequest) (*pb.UpdateSeriesResponse, error) {
+	var series models.Series
+
+	// 查询丛书
+	if err := s.db.First(&series, req.GetId()).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "Series not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to query series: %v", err)
+	}
+
+	// 更新字段
+	if req.GetName() != "" {
+		series.Name = req.GetName()
+	}
+	if req.GetDescription() != "" {
+		series.Description = req.GetDescription()
+	}
+	series.UpdatedAt = time.Now()
+
+	// 保存更新
+	if err := s.db.Save(&series).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "Series already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update series: %v", err)
+	}
+
+	// 返回成功的响应
+	return &pb.UpdateSeriesResponse{
+		Success:  true,
+		Feedback: "Series updated successfully",
+	}, nil
+}
+
+// DeleteSeries 删除丛书，书籍与该丛书的关联会被清除
+func (s *SeriesServiceServer) DeleteSeries(ctx context.Context, req *pb.DeleteSeriesRequest) (*pb.DeleteSeriesResponse, error) {
+	var series models.Series
+
+	// 查询丛书
+	if err := s.db.First(&series, req.GetId()).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "Series not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to query series: %v", err)
+	}
+
+	// 删除丛书
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Book{}).Where("series_id = ?", series.ID).Update("series_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&series).Error
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete series: %v", err)
+	}
+
+	// 返回成功的响应
+	return &pb.DeleteSeriesResponse{
+		Success:  true,
+		Feedback: "Series deleted successfully",
+	}, nil
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// invalidArgumentError 构造 InvalidArgument 错误，并附带 BadRequest 详情指出出错的字段
+func invalidArgumentError(field, description string) error {
+	st := status.New(codes.InvalidArgument, description)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: description,
+		}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// validateRange 验证分页查询的 start 和 stop 参数
+func validateRange(start, stop int32) error {
+	if start < 0 {
+		return invalidArgumentError("start", "Invalid range: start must be >= 0 and stop must be >= start")
+	}
+	if stop < start {
+		return invalidArgumentError("stop", "Invalid range: start must be >= 0 and stop must be >= start")
+	}
+	return nil
+}
+
+// toStatusError 把不是 gRPC 状态的错误包装为 Internal，已经是状态错误的原样返回
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// isDuplicateKeyError 判断错误是否由唯一索引冲突引起，需要在 gorm.Config 中开启 TranslateError
+func isDuplicateKeyError(err error) bool {
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusErrors(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewPublisherServiceServer(db)
+	orderServer := NewCustomerOrderServiceServer(db)
+
+	// 参数错误带有 BadRequest 详情
+	_, err := server.CreatePublisher(context.Background(), &pb.CreatePublisherRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	details := status.Convert(err).Details()
+	assert.Equal(t, 1, len(details))
+	badRequest, ok := details[0].(*errdetails.BadRequest)
+	assert.True(t, ok)
+	assert.Equal(t, "name", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "Name is required", badRequest.GetFieldViolations()[0].GetDescription())
+
+	// 订单明细的出错字段带有下标
+	_, err = orderServer.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate:        "2023-01-01",
+		CustomerOnlineId: "customer1",
+		Address:          "Address 1",
+		Items: []*pb.CustomerOrderItem{
+			{BookNo: "B001", BookCount: 1, Price: 100},
+			{BookNo: "B002", BookCount: 0, Price: 100},
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	badRequest = status.Convert(err).Details()[0].(*errdetails.BadRequest)
+	assert.Equal(t, "items[1].book_count", badRequest.GetFieldViolations()[0].GetField())
+
+	// 分页参数错误
+	_, err = server.GetPublisher(context.Background(), &pb.GetPublisherRequest{Start: 5, Stop: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	badRequest = status.Convert(err).Details()[0].(*errdetails.BadRequest)
+	assert.Equal(t, "stop", badRequest.GetFieldViolations()[0].GetField())
+
+	// 唯一索引冲突返回 AlreadyExists
+	resp, err := server.CreatePublisher(context.Background(), &pb.CreatePublisherRequest{Name: "Tech Press"})
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	_, err = server.CreatePublisher(context.Background(), &pb.CreatePublisherRequest{Name: "Tech Press"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// 不存在的记录返回 NotFound
+	_, err = server.UpdatePublisher(context.Background(), &pb.UpdatePublisherRequest{Id: 100, Name: "Other"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// badRequestField 返回 InvalidArgument 错误的 BadRequest 详情中第一个出错的字段
+func badRequestField(t *testing.T, err error) string {
+	t.Helper()
+	if !assert.Equal(t, codes.InvalidArgument, status.Code(err)) {
+		return ""
+	}
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok && len(badRequest.GetFieldViolations()) > 0 {
+			return badRequest.GetFieldViolations()[0].GetField()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// 库存变动原因与 protobuf 枚举的对应关系
+var pbStockMovementReasons = map[string]pb.StockMovementReason{
+	models.StockMovementSale:       pb.StockMovementReason_STOCK_MOVEMENT_REASON_SALE,
+	models.StockMovementReceipt:    pb.StockMovementReason_STOCK_MOVEMENT_REASON_RECEIPT,
+	models.StockMovementAdjustment: pb.StockMovementReason_STOCK_MOVEMENT_REASON_ADJUSTMENT,
+	models.StockMovementReturn:     pb.StockMovementReason_STOCK_MOVEMENT_REASON_RETURN,
+}
+
+// StockDiscrepancy 是一本变动之和与在库数量不一致的书
+type StockDiscrepancy struct {
+	BookNo        string
+	StockQuantity int32
+	MovementTotal int32
+}
+
+// recordStockMovement 在修改在库数量的事务 tx 中写入一条库存变动，delta 为 0 时不写入
+func recordStockMovement(tx *gorm.DB, bookNo string, delta int32, reason string, referenceID int32, actor string) error {
+	if delta == 0 {
+		return nil
+	}
+	movement := &models.StockMovement{
+		BookNo:      bookNo,
+		Delta:       delta,
+		Reason:      reason,
+		ReferenceID: referenceID,
+		Actor:       actor,
+		CreatedAt:   time.Now(),
+	}
+	if err := tx.Create(movement).Error; err != nil {
+		return status.Errorf(codes.Internal, "Failed to record stock movement: %v", err)
+	}
+	return nil
+}
+
+// stockMovementTotal 返回书籍 bookNo 全部库存变动之和
+func stockMovementTotal(db *gorm.DB, bookNo string) (int32, error) {
+	var total int64
+	if err := db.Model(&models.StockMovement{}).Where("book_no = ?", bookNo).
+		Select("COALESCE(SUM(delta), 0)").Scan(&total).Error; err != nil {
+		return 0, status.Errorf(codes.Internal, "Failed to sum stock movements: %v", err)
+	}
+	return int32(total), nil
+}
+
+// ReconcileStock 核对每本书的库存变动之和是否等于在库数量，返回不一致的书
+func ReconcileStock(ctx context.Context, db *gorm.DB) ([]StockDiscrepancy, error) {
+	var discrepancies []StockDiscrepancy
+	err := db.WithContext(ctx).Table("books").
+		Select("books.book_no, books.stock_quantity, COALESCE(SUM(stock_movements.delta), 0) AS movement_total").
+		Joins("LEFT JOIN stock_movements ON stock_movements.book_no = books.book_no").
+		Group("books.book_no, books.stock_quantity").
+		Having("books.stock_quantity <> COALESCE(SUM(stock_movements.delta), 0)").
+		Order("books.book_no").
+		Scan(&discrepancies).Error
+	if err != nil {
+		return nil, err
+	}
+	return discrepancies, nil
+}
+
+// GetStockHistory 查询书籍的库存变动记录，按时间先后排列，并核对全部变动之和是否等于当前库存
+func (s *BookServiceServer) GetStockHistory(ctx context.Context, req *pb.GetStockHistoryRequest) (*pb.GetStockHistoryResponse, error) {
+	// 验证用户输入
+	if req.GetBookNo() == "" {
+		return nil, invalidArgumentError("book_no", "Book No is required")
+	}
+
+	// 查找书籍
+	var book models.Book
+	if err := s.db.Where("book_no = ?", req.GetBookNo()).First(&book).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "Book not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to query book: %v", err)
+	}
+
+	// 查询变动记录
+	query := s.db.Where("book_no = ?", req.GetBookNo())
+	result, err := paginate(query, req, pageScope("stock_movements", req.GetBookNo()), func(movement *models.StockMovement) (time.Time, int32) {
+		return movement.CreatedAt, movement.ID
+	})
+	if err != nil {
+		return nil, err
+	}
+	total, err := stockMovementTotal(s.db, req.GetBookNo())
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建响应
+	var movements []*pb.StockMovement
+	for _, movement := range result.Items {
+		movements = append(movements, &pb.StockMovement{
+			Id:          movement.ID,
+			BookNo:      movement.BookNo,
+			Delta:       movement.Delta,
+			Reason:      pbStockMovementReasons[movement.Reason],
+			ReferenceId: movement.ReferenceID,
+			Actor:       movement.Actor,
+			CreatedAt:   timestamppb.New(movement.CreatedAt),
+		})
+	}
+	return &pb.GetStockHistoryResponse{
+		Success:       true,
+		Feedback:      "Stock history retrieved successfully",
+		Movements:     movements,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    result.TotalCount,
+		StockQuantity: book.StockQuantity,
+		MovementTotal: total,
+		Reconciled:    total == book.StockQuantity,
+	}, nil
+}
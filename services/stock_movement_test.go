@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStockHistory(t *testing.T) {
+	db := setupTestDB(t)
+	bookServer := NewBookServiceServer(db)
+	customerOrderServer := NewCustomerOrderServiceServer(db)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	clerk := callerContext("clerk1", RoleClerk)
+
+	db.Create(&models.Customer{OnlineID: "customer1", Name: "Customer 1", Address: "Address 1", AccountBalance: 1000, CreditLevel: 1})
+
+	// 新书的初始库存和修改库存记为调整
+	_, err := bookServer.CreateBook(clerk, &pb.CreateBookRequest{BookNo: "B001", Title: "Book 1", PublisherName: "Publisher", Price: 100, StockQuantity: 10})
+	assert.NoError(t, err)
+	_, err = bookServer.UpdateBook(clerk, &pb.UpdateBookRequest{BookNo: "B001", StockQuantity: 12})
+	assert.NoError(t, err)
+
+	// 下单记为销售，取消记为退货
+	orderResp, err := customerOrderServer.CreateCustomerOrder(callerContext("customer1", RoleCustomer), &pb.CreateCustomerOrderRequest{
+		OrderDate: "2023-01-01", CustomerOnlineId: "customer1", Address: "Address 1",
+		Items: []*pb.CustomerOrderItem{{BookNo: "B001", BookCount: 3, Price: 300}},
+	})
+	assert.NoError(t, err)
+	_, err = customerOrderServer.CancelCustomerOrder(clerk, &pb.CancelCustomerOrderRequest{Id: orderResp.Id})
+	assert.NoError(t, err)
+
+	// 采购单到货记为入库
+	db.Create(&models.PurchaseOrder{Supplier: "Supplier", OrderDate: "2023-01-02", Items: []*models.PurchaseOrderItem{{BookNo: "B001", Title: "Book 1", Publisher: "Publisher", Author: "Author", Quantity: 5}}})
+	_, err = purchaseOrderServer.UpdatePurchaseOrder(callerContext("buyer1", RolePurchasing), &pb.UpdatePurchaseOrderRequest{Id: 1, Finished: true})
+	assert.NoError(t, err)
+
+	resp, err := bookServer.GetStockHistory(clerk, &pb.GetStockHistoryRequest{BookNo: "B001", PageSize: 10})
+	assert.NoError(t, err)
+	var history []string
+	for _, movement := range resp.Movements {
+		history = append(history, movement.Reason.String()+"/"+movement.Actor)
+	}
+	assert.Equal(t, []string{
+		"STOCK_MOVEMENT_REASON_ADJUSTMENT/clerk1",
+		"STOCK_MOVEMENT_REASON_ADJUSTMENT/clerk1",
+		"STOCK_MOVEMENT_REASON_SALE/customer1",
+		"STOCK_MOVEMENT_REASON_RETURN/clerk1",
+		"STOCK_MOVEMENT_REASON_RECEIPT/buyer1",
+	}, history)
+	assert.Equal(t, []int32{10, 2, -3, 3, 5}, []int32{resp.Movements[0].Delta, resp.Movements[1].Delta, resp.Movements[2].Delta, resp.Movements[3].Delta, resp.Movements[4].Delta})
+	assert.Equal(t, orderResp.Id, resp.Movements[2].ReferenceId)
+	assert.Equal(t, int32(1), resp.Movements[4].ReferenceId)
+	assert.Equal(t, int32(17), resp.StockQuantity)
+	assert.Equal(t, int32(17), resp.MovementTotal)
+	assert.True(t, resp.Reconciled)
+
+	discrepancies, err := ReconcileStock(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Empty(t, discrepancies)
+
+	// 绕过服务直接修改库存后核对不一致
+	db.Model(&models.Book{}).Where("book_no = ?", "B001").Update("stock_quantity", 20)
+	resp, err = bookServer.GetStockHistory(clerk, &pb.GetStockHistoryRequest{BookNo: "B001", PageSize: 10})
+	assert.NoError(t, err)
+	assert.False(t, resp.Reconciled)
+	discrepancies, err = ReconcileStock(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Equal(t, []StockDiscrepancy{{BookNo: "B001", StockQuantity: 20, MovementTotal: 17}}, discrepancies)
+}
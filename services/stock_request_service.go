@@ -2,13 +2,12 @@ package services
 
 import (
 	"context"
-	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
@@ -29,38 +28,23 @@ func NewStockRequestServiceServer(db *gorm.DB) *StockRequestServiceServer {
 func (s *StockRequestServiceServer) CreateStockRequest(ctx context.Context, req *pb.CreateStockRequestRequest) (*pb.CreateStockRequestResponse, error) {
 	// 验证用户输入
 	if req.GetBookNo() == "" {
-		return &pb.CreateStockRequestResponse{
-			Success:  false,
-			Feedback: "BookNo is required",
-		}, nil
+		return nil, invalidArgumentError("book_no", "BookNo is required")
 	}
 
 	if req.GetTitle() == "" {
-		return &pb.CreateStockRequestResponse{
-			Success:  false,
-			Feedback: "Title is required",
-		}, nil
+		return nil, invalidArgumentError("title", "Title is required")
 	}
 
 	if req.GetPublisher() == "" {
-		return &pb.CreateStockRequestResponse{
-			Success:  false,
-			Feedback: "Publisher is required",
-		}, nil
+		return nil, invalidArgumentError("publisher", "Publisher is required")
 	}
 
 	if req.GetQuantity() <= 0 {
-		return &pb.CreateStockRequestResponse{
-			Success:  false,
-			Feedback: "Quantity must be greater than 0",
-		}, nil
+		return nil, invalidArgumentError("quantity", "Quantity must be greater than 0")
 	}
 
 	if req.GetRequestDate() == "" {
-		return &pb.CreateStockRequestResponse{
-			Success:  false,
-			Feedback: "Request date is required",
-		}, nil
+		return nil, invalidArgumentError("request_date", "Request date is required")
 	}
 
 	// 构建新的 StockRequest 对象
@@ -78,10 +62,7 @@ func (s *StockRequestServiceServer) CreateStockRequest(ctx context.Context, req
 
 	// 写入数据库
 	if err := s.db.Create(&stockRequest).Error; err != nil {
-		return &pb.CreateStockRequestResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to create stock request: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to create stock request: %v", err)
 	}
 
 	// 返回成功的响应
@@ -130,79 +111,59 @@ func (s *StockRequestServiceServer) UpdateStockRequest(ctx context.Context, req
 	// 如果查询失败，返回错误
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.UpdateStockRequestResponse{
-				Success:  false,
-				Feedback: "Stock request not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Stock request not found")
 		}
-		return &pb.UpdateStockRequestResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query stock request: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "Failed to query stock request: %v", err)
 	}
 
 	// 更新字段
 	stockRequest.Finished = req.GetFinished() || stockRequest.Finished
 
-	// 保存更新
+	// 保存更新，等待到货的客户在采购单到货时处理
 	if err := s.db.Save(&stockRequest).Error; err != nil {
-		return &pb.UpdateStockRequestResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to update stock request: %v", err),
-		}, nil
-	}
-
-	emailFeedback := "Stock request updated successfully"
-
-	// 生成假电子邮件
-	if stockRequest.Finished {
-		emailFeedback = s.generateFakeEmail(stockRequest)
+		return nil, status.Errorf(codes.Internal, "Failed to update stock request: %v", err)
 	}
 
 	// 返回成功的响应
 	return &pb.UpdateStockRequestResponse{
 		Success:  true,
-		Feedback: emailFeedback,
+		Feedback: "Stock request updated successfully",
 	}, nil
 }
 
 // GetStockRequest 获取缺书登记
 func (s *StockRequestServiceServer) GetStockRequest(ctx context.Context, req *pb.GetStockRequestRequest) (*pb.GetStockRequestResponse, error) {
-	// 验证请求参数
-	if req.GetStart() < 0 || req.GetStop() < req.GetStart() {
-		return &pb.GetStockRequestResponse{
-			Success:  false,
-			Feedback: "Invalid range: start must be >= 0 and stop must be >= start",
-		}, nil
+	// 验证过滤条件
+	created, err := createdBetween(req.GetCreatedAfter(), req.GetCreatedBefore())
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建查询
+	query := s.db.Scopes(created)
+	if req.GetBookNo() != "" {
+		query = query.Where("book_no = ?", req.GetBookNo())
+	}
+	if req.GetSupplier() != "" {
+		query = query.Where("supplier = ?", req.GetSupplier())
+	}
+	if req.Finished != nil {
+		query = query.Where("finished = ?", req.GetFinished())
 	}
 
 	// 查询缺书登记
-	var stockRequests []*models.StockRequest
-	if err := s.db.Offset(int(req.GetStart())).Limit(int(req.GetStop() - req.GetStart() + 1)).Find(&stockRequests).Error; err != nil {
-		return &pb.GetStockRequestResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query stock requests: %v", err),
-		}, nil
+	scope := pageScope("stock_requests", req.GetBookNo(), req.GetSupplier(), req.Finished, req.GetCreatedAfter(), req.GetCreatedBefore())
+	result, err := paginate(query, req, scope, func(stockRequest *models.StockRequest) (time.Time, int32) {
+		return stockRequest.CreatedAt, stockRequest.ID
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// 构建响应
 	var pbStockRequests []*pb.StockRequest
-	for _, stockRequest := range stockRequests {
-		pbStockRequest := &pb.StockRequest{
-			Id:          stockRequest.ID,
-			BookNo:      stockRequest.BookNo,
-			Title:       stockRequest.Title,
-			Publisher:   stockRequest.Publisher,
-			Supplier:    stockRequest.Supplier,
-			Author:      stockRequest.Author,
-			Quantity:    stockRequest.Quantity,
-			RequestDate: stockRequest.RequestDate,
-			Finished:    stockRequest.Finished,
-			CreatedAt:   timestamppb.New(stockRequest.CreatedAt),
-			UpdatedAt:   timestamppb.New(stockRequest.UpdatedAt),
-		}
-
-		pbStockRequests = append(pbStockRequests, pbStockRequest)
+	for _, stockRequest := range result.Items {
+		pbStockRequests = append(pbStockRequests, toPbStockRequest(stockRequest))
 	}
 
 	// 返回响应
@@ -210,6 +171,8 @@ func (s *StockRequestServiceServer) GetStockRequest(ctx context.Context, req *pb
 		Success:       true,
 		Feedback:      "Stock requests retrieved successfully",
 		StockRequests: pbStockRequests,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    result.TotalCount,
 	}, nil
 }
 
@@ -219,75 +182,43 @@ func (s *StockRequestServiceServer) DeleteStockRequest(ctx context.Context, req
 	var stockRequest models.StockRequest
 	if err := s.db.First(&stockRequest, req.GetStockRequestId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.DeleteStockRequestResponse{
-				Success:  false,
-				Feedback: "Stock request not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Stock request not found")
 		}
-		return &pb.DeleteStockRequestResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to query stock request: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "Failed to query stock request: %v", err)
 	}
 
-	// 删除缺书登记
+	// 删除缺书登记，等待到货的客户仍然按书号等待下一次到货
 	if err := s.db.Delete(&stockRequest).Error; err != nil {
-		return &pb.DeleteStockRequestResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("Failed to delete stock request: %v", err),
-		}, nil
-	}
-
-	feedback := "Stock request deleted successfully"
-
-	// 生成假电子邮件
-	if !stockRequest.Finished {
-		// 如果缺书登记没有完成，生成假电子邮件
-		feedback = s.generateFakeEmail(stockRequest)
+		return nil, status.Errorf(codes.Internal, "Failed to delete stock request: %v", err)
 	}
 
 	// 返回成功的响应
 	return &pb.DeleteStockRequestResponse{
 		Success:  true,
-		Feedback: feedback,
+		Feedback: "Stock request deleted successfully",
 	}, nil
 }
 
-// generateFakeEmail 生成假电子邮件
-func (s *StockRequestServiceServer) generateFakeEmail(stockRequest models.StockRequest) string {
-	// 创建目录
-	dir := ".\\fake_emails"
-	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-		fmt.Printf("Failed to create directory: %v\n", err)
-		return "Failed to create directory"
-	}
-
-	// 查找相关客户订单
-	var customerOrders []models.CustomerOrder
-	if err := s.db.Where("book_no = ?", stockRequest.BookNo).Find(&customerOrders).Error; err != nil {
-		fmt.Printf("Failed to query customer orders: %v\n", err)
-		return "Failed to query customer orders"
-	}
-
-	if len(customerOrders) == 0 {
-		return fmt.Sprintf("没有客户创建了书目%s的相关订单，无须发送邮件", stockRequest.Title)
-	}
-
-	// 生成假电子邮件
-	for _, order := range customerOrders {
-		var customer models.Customer
-		if err := s.db.Where("online_id = ?", order.CustomerOnlineID).First(&customer).Error; err != nil {
-			fmt.Printf("Failed to query customer: %v\n", err)
-			continue
-		}
-
-		emailContent := fmt.Sprintf("客户%s您好，您要的%s书目已经上新", customer.Name, stockRequest.Title)
-		today := time.Now().Format("2006-01-02")
-		emailFile := filepath.Join(dir, fmt.Sprintf("email_%d_%s.txt", order.ID, today))
-		if err := os.WriteFile(emailFile, []byte(emailContent), 0644); err != nil {
-			fmt.Printf("Failed to write email file: %v\n", err)
-		}
-	}
-
-	return "已暂存电子邮件通知客户"
+// toPbStockRequest 把缺书登记转换为 pb.StockRequest
+func toPbStockRequest(stockRequest *models.StockRequest) *pb.StockRequest {
+	pbStockRequest := &pb.StockRequest{
+		Id:          stockRequest.ID,
+		BookNo:      stockRequest.BookNo,
+		Title:       stockRequest.Title,
+		Publisher:   stockRequest.Publisher,
+		Supplier:    stockRequest.Supplier,
+		Author:      stockRequest.Author,
+		Quantity:    stockRequest.Quantity,
+		RequestDate: stockRequest.RequestDate,
+		Finished:    stockRequest.Finished,
+		CreatedAt:   timestamppb.New(stockRequest.CreatedAt),
+		UpdatedAt:   timestamppb.New(stockRequest.UpdatedAt),
+	}
+	if stockRequest.CustomerOrderID != nil {
+		pbStockRequest.CustomerOrderId = *stockRequest.CustomerOrderID
+	}
+	if stockRequest.PurchaseOrderID != nil {
+		pbStockRequest.PurchaseOrderId = *stockRequest.PurchaseOrderID
+	}
+	return pbStockRequest
 }
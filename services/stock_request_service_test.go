@@ -3,34 +3,22 @@ package services
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"testing"
-	"time"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
-func setupTestDBStockRequest(t *testing.T) *gorm.DB {
-	db, _ := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err := models.AutoMigrate(db); err != nil {
-		t.Fatalf("failed to migrate database: %v", err)
-	}
-	return db
-}
-
 func TestCreateUpdateGetDeleteStockRequest(t *testing.T) {
-	db := setupTestDBStockRequest(t)
+	db := setupTestDB(t)
 	server := NewStockRequestServiceServer(db)
 
 	// 添加客户
 	customers := []models.Customer{
-		{OnlineID: "customer1", Password: "password", Name: "Customer 1", Address: "Address 1", AccountBalance: 1000, CreditLevel: 1},
-		{OnlineID: "customer2", Password: "password", Name: "Customer 2", Address: "Address 2", AccountBalance: 2000, CreditLevel: 2},
+		{OnlineID: "customer1", Password: "password", Name: "Customer 1", Address: "Address 1", AccountBalance: 1000, CreditLevel: 1, Email: "customer1@example.com"},
+		{OnlineID: "customer2", Password: "password", Name: "Customer 2", Address: "Address 2", AccountBalance: 2000, CreditLevel: 2, Email: "customer2@example.com"},
 	}
 	for _, customer := range customers {
 		db.Create(&customer)
@@ -39,8 +27,8 @@ func TestCreateUpdateGetDeleteStockRequest(t *testing.T) {
 	// 添加客户订单并获取生成的订单 ID
 	var orderIDs []int32
 	orders := []models.CustomerOrder{
-		{OrderDate: "2023-01-01", CustomerOnlineID: "customer1", BookNo: "B001", BookCount: 1, Price: 100, Address: "Address 1", Status: "未发货"},
-		{OrderDate: "2023-01-01", CustomerOnlineID: "customer2", BookNo: "B002", BookCount: 1, Price: 200, Address: "Address 2", Status: "未发货"},
+		{OrderDate: "2023-01-01", CustomerOnlineID: "customer1", Price: 100, Address: "Address 1", Status: "未发货", Items: []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 1, Price: 100}}},
+		{OrderDate: "2023-01-01", CustomerOnlineID: "customer2", Price: 200, Address: "Address 2", Status: "未发货", Items: []*models.CustomerOrderItem{{BookNo: "B002", BookCount: 1, Price: 200}}},
 	}
 	for i := range orders {
 		db.Create(&orders[i])
@@ -79,7 +67,7 @@ func TestCreateUpdateGetDeleteStockRequest(t *testing.T) {
 		assert.False(t, stockRequest.Finished)
 	}
 
-	// 更新所有缺书登记为 finished = true，并验证电子邮件发送情况
+	// 更新所有缺书登记为 finished = true
 	for i := 1; i <= 10; i++ {
 		req := &pb.UpdateStockRequestRequest{
 			Id:       int32(i),
@@ -88,11 +76,7 @@ func TestCreateUpdateGetDeleteStockRequest(t *testing.T) {
 		resp, err := server.UpdateStockRequest(context.Background(), req)
 		assert.NoError(t, err)
 		assert.True(t, resp.Success)
-		if i == 1 || i == 2 {
-			assert.Equal(t, "已暂存电子邮件通知客户", resp.Feedback)
-		} else {
-			assert.Equal(t, "没有客户创建了书目"+fmt.Sprintf("Book Title %d", i)+"的相关订单，无须发送邮件", resp.Feedback)
-		}
+		assert.Equal(t, "Stock request updated successfully", resp.Feedback)
 	}
 
 	// 查询并验证所有缺书登记的 finished 字段是否为 true
@@ -143,18 +127,10 @@ func TestCreateUpdateGetDeleteStockRequest(t *testing.T) {
 		assert.Equal(t, gorm.ErrRecordNotFound, err)
 	}
 
-	// 验证生成的假电子邮件
-	dir := ".\\fake_emails"
-	for _, order := range orders {
-		today := time.Now().Format("2006-01-02")
-		emailFile := filepath.Join(dir, fmt.Sprintf("email_%d_%s.txt", order.ID, today))
-		_, err := os.Stat(emailFile)
-		if order.BookNo == "B001" || order.BookNo == "B002" {
-			assert.NoError(t, err)
-		} else {
-			assert.Error(t, err)
-		}
-	}
+	// 缺书登记完成时书还没有到货，不会通知曾经订购过这些书的客户
+	var count int64
+	db.Model(&models.OutboxMessage{}).Count(&count)
+	assert.Equal(t, int64(0), count)
 
 	// 添加一个没有相应订单的缺书登记
 	req := &pb.CreateStockRequestRequest{
@@ -171,7 +147,7 @@ func TestCreateUpdateGetDeleteStockRequest(t *testing.T) {
 	assert.True(t, resp.Success)
 	assert.Equal(t, "Stock request created successfully", resp.Feedback)
 
-	// 更新缺书登记为 finished = true，并验证电子邮件发送情况
+	// 更新缺书登记为 finished = true
 	reqUpdate := &pb.UpdateStockRequestRequest{
 		Id:       11,
 		Finished: true,
@@ -179,5 +155,5 @@ func TestCreateUpdateGetDeleteStockRequest(t *testing.T) {
 	respUpdate, err := server.UpdateStockRequest(context.Background(), reqUpdate)
 	assert.NoError(t, err)
 	assert.True(t, respUpdate.Success)
-	assert.Equal(t, "没有客户创建了书目Book Title 11的相关订单，无须发送邮件", respUpdate.Feedback)
+	assert.Equal(t, "Stock request updated successfully", respUpdate.Feedback)
 }
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// streamBatchSize 是导出时每批从数据库读取的记录数
+const streamBatchSize = 200
+
+// streamRecords 按主键分批读取查询结果，每条记录调用一次 send，客户端取消或发送失败时停止读取。
+// 查询不能带 Order，FindInBatches 会按主键排序。
+func streamRecords[T any](ctx context.Context, query *gorm.DB, send func(*T) error) error {
+	var batch []*T
+	var stopErr error
+	result := query.WithContext(ctx).FindInBatches(&batch, streamBatchSize, func(tx *gorm.DB, _ int) error {
+		for _, item := range batch {
+			if err := ctx.Err(); err != nil {
+				stopErr = status.FromContextError(err).Err()
+				return stopErr
+			}
+			if err := send(item); err != nil {
+				stopErr = err
+				return err
+			}
+		}
+		return nil
+	})
+
+	// 客户端取消或发送失败时直接返回对应的错误
+	if stopErr != nil {
+		return stopErr
+	}
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "Failed to query records: %v", result.Error)
+	}
+	return nil
+}
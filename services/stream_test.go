@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStream 记录服务端发送的消息，onSend 在每次发送后调用
+type fakeStream[T any] struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sent   []*T
+	onSend func(count int)
+}
+
+func (s *fakeStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream[T]) Send(message *T) error {
+	s.sent = append(s.sent, message)
+	if s.onSend != nil {
+		s.onSend(len(s.sent))
+	}
+	return nil
+}
+
+func TestStreamPurchaseOrders(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewPurchaseOrderServiceServer(db)
+
+	// 添加超过一批的采购单，奇数的已完成
+	total := streamBatchSize*2 + 10
+	for i := 0; i < total; i++ {
+		db.Create(&models.PurchaseOrder{
+			Supplier: "Supplier A",
+			Items: []*models.PurchaseOrderItem{{
+				BookNo: fmt.Sprintf("B%03d", i), Title: "Title", Publisher: "Publisher", Author: "Author", Quantity: 1,
+			}},
+			OrderDate: "2023-01-01",
+			Finished:  i%2 == 1,
+		})
+	}
+
+	// 导出全部采购单，按 id 升序
+	stream := &fakeStream[pb.PurchaseOrder]{ctx: context.Background()}
+	assert.NoError(t, server.StreamPurchaseOrders(&pb.StreamPurchaseOrdersRequest{}, stream))
+	assert.Equal(t, total, len(stream.sent))
+	for i, order := range stream.sent {
+		assert.Equal(t, int32(i+1), order.Id)
+	}
+
+	// 过滤条件与分页查询相同
+	finished := true
+	stream = &fakeStream[pb.PurchaseOrder]{ctx: context.Background()}
+	assert.NoError(t, server.StreamPurchaseOrders(&pb.StreamPurchaseOrdersRequest{Finished: &finished}, stream))
+	assert.Equal(t, total/2, len(stream.sent))
+	for _, order := range stream.sent {
+		assert.True(t, order.Finished)
+	}
+	stream = &fakeStream[pb.PurchaseOrder]{ctx: context.Background()}
+	assert.NoError(t, server.StreamPurchaseOrders(&pb.StreamPurchaseOrdersRequest{BookNo: "B007"}, stream))
+	assert.Equal(t, 1, len(stream.sent))
+
+	// 客户端取消后停止发送
+	ctx, cancel := context.WithCancel(context.Background())
+	stream = &fakeStream[pb.PurchaseOrder]{ctx: ctx, onSend: func(count int) {
+		if count == 5 {
+			cancel()
+		}
+	}}
+	err := server.StreamPurchaseOrders(&pb.StreamPurchaseOrdersRequest{}, stream)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, 5, len(stream.sent))
+}
+
+func TestStreamCustomerOrders(t *testing.T) {
+	db := setupTestDB(t)
+	server := NewCustomerOrderServiceServer(db)
+
+	orders := []models.CustomerOrder{
+		{OrderDate: "2023-01-01", CustomerOnlineID: "customer1", Address: "Address 1", Status: models.OrderStatusPaid, Items: []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 1}}},
+		{OrderDate: "2023-01-02", CustomerOnlineID: "customer1", Address: "Address 1", Status: models.OrderStatusShipped, Items: []*models.CustomerOrderItem{{BookNo: "B002", BookCount: 1}}},
+		{OrderDate: "2023-01-03", CustomerOnlineID: "customer2", Address: "Address 2", Status: models.OrderStatusPaid, Items: []*models.CustomerOrderItem{{BookNo: "B001", BookCount: 1}, {BookNo: "B003", BookCount: 2}}},
+	}
+	for i := range orders {
+		db.Create(&orders[i])
+	}
+
+	// 按书号过滤，明细完整返回
+	stream := &fakeStream[pb.CustomerOrder]{ctx: context.Background()}
+	assert.NoError(t, server.StreamCustomerOrders(&pb.StreamCustomerOrdersRequest{BookNo: "B001"}, stream))
+	assert.Equal(t, 2, len(stream.sent))
+	assert.Equal(t, 2, len(stream.sent[1].Items))
+
+	// 客户只能导出自己的订单
+	stream = &fakeStream[pb.CustomerOrder]{ctx: callerContext("customer2", RoleCustomer)}
+	assert.NoError(t, server.StreamCustomerOrders(&pb.StreamCustomerOrdersRequest{}, stream))
+	assert.Equal(t, 1, len(stream.sent))
+	assert.Equal(t, orders[2].ID, stream.sent[0].Id)
+
+	// 无效的过滤条件
+	stream = &fakeStream[pb.CustomerOrder]{ctx: context.Background()}
+	err := server.StreamCustomerOrders(&pb.StreamCustomerOrdersRequest{OrderStatus: pb.OrderStatus(99)}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthInterceptorStream(t *testing.T) {
+	signer := NewTokenSigner([]byte("test-secret"), time.Hour)
+	interceptor := NewAuthInterceptor(signer)
+
+	// 调用拦截器，返回 handler 收到的调用者
+	call := func(method, role string) (*TokenClaims, error) {
+		ctx := context.Background()
+		if role != "" {
+			token, _, _ := signer.Sign("user1", role)
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		var caller *TokenClaims
+		err := interceptor.Stream(nil, &fakeStream[pb.Book]{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method}, func(srv interface{}, stream grpc.ServerStream) error {
+			caller, _ = callerFromContext(stream.Context())
+			return nil
+		})
+		return caller, err
+	}
+
+	caller, err := call(pb.CustomerOrderService_StreamCustomerOrders_FullMethodName, RoleCustomer)
+	assert.NoError(t, err)
+	assert.Equal(t, "user1", caller.OnlineID)
+	_, err = call(pb.BookService_StreamBooks_FullMethodName, "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = call(pb.PurchaseOrderService_StreamPurchaseOrders_FullMethodName, RoleClerk)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
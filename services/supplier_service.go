@@ -2,11 +2,12 @@ package services
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
 	"github.com/GoldenStain/goDB/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
@@ -39,10 +40,7 @@ func NewSupplyBookServiceServer(db *gorm.DB) *SupplyBookServiceServer {
 func (s *SupplierServiceServer) CreateSupplier(ctx context.Context, req *pb.CreateSupplierRequest) (*pb.CreateSupplierResponse, error) {
 	// 验证用户输入
 	if req.GetName() == "" {
-		return &pb.CreateSupplierResponse{
-			Success:  false,
-			Feedback: "Name is required",
-		}, nil
+		return nil, invalidArgumentError("name", "Name is required")
 	}
 
 	// 构建新的 Supplier 对象
@@ -50,16 +48,14 @@ func (s *SupplierServiceServer) CreateSupplier(ctx context.Context, req *pb.Crea
 		Name:       req.GetName(),
 		BasicInfo:  req.GetBasicInfo(),
 		SupplyInfo: req.GetSupplyInfo(),
+		Preferred:  req.GetPreferred(),
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
 	}
 
 	// 写入数据库
 	if err := s.db.Create(&supplier).Error; err != nil {
-		return &pb.CreateSupplierResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to create supplier: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to create supplier: %v", err)
 	}
 
 	// 添加供应的书籍
@@ -75,10 +71,10 @@ func (s *SupplierServiceServer) CreateSupplier(ctx context.Context, req *pb.Crea
 			UpdatedAt:     time.Now(),
 		}
 		if err := s.db.Create(&bookModel).Error; err != nil {
-			return &pb.CreateSupplierResponse{
-				Success:  false,
-				Feedback: fmt.Sprintf("failed to create book: %v", err),
-			}, nil
+			if isDuplicateKeyError(err) {
+				return nil, status.Error(codes.AlreadyExists, "Supply book already exists")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to create book: %v", err)
 		}
 	}
 
@@ -91,19 +87,17 @@ func (s *SupplierServiceServer) CreateSupplier(ctx context.Context, req *pb.Crea
 
 // GetSupplier 获取供应商
 func (s *SupplierServiceServer) GetSupplier(ctx context.Context, req *pb.GetSupplierRequest) (*pb.GetSupplierResponse, error) {
-	var suppliers []models.Supplier
-
 	// 查询供应商
-	if err := s.db.Preload("SupplyBooks").Offset(int(req.GetStart())).Limit(int(req.GetStop() - req.GetStart() + 1)).Find(&suppliers).Error; err != nil {
-		return &pb.GetSupplierResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query suppliers: %v", err),
-		}, nil
+	result, err := paginate(s.db.Preload("SupplyBooks"), req, pageScope("suppliers"), func(supplier *models.Supplier) (time.Time, int32) {
+		return supplier.CreatedAt, supplier.ID
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// 构建响应
 	var pbSuppliers []*pb.Supplier
-	for _, supplier := range suppliers {
+	for _, supplier := range result.Items {
 		var pbBooks []*pb.SupplyBook
 		for _, book := range supplier.SupplyBooks {
 			pbBook := &pb.SupplyBook{
@@ -126,6 +120,7 @@ func (s *SupplierServiceServer) GetSupplier(ctx context.Context, req *pb.GetSupp
 			BasicInfo:   supplier.BasicInfo,
 			SupplyInfo:  supplier.SupplyInfo,
 			SupplyBooks: pbBooks,
+			Preferred:   supplier.Preferred,
 			CreatedAt:   timestamppb.New(supplier.CreatedAt),
 			UpdatedAt:   timestamppb.New(supplier.UpdatedAt),
 		}
@@ -134,9 +129,11 @@ func (s *SupplierServiceServer) GetSupplier(ctx context.Context, req *pb.GetSupp
 
 	// 返回成功的响应
 	return &pb.GetSupplierResponse{
-		Success:   true,
-		Feedback:  "Suppliers retrieved successfully",
-		Suppliers: pbSuppliers,
+		Success:       true,
+		Feedback:      "Suppliers retrieved successfully",
+		Suppliers:     pbSuppliers,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    result.TotalCount,
 	}, nil
 }
 
@@ -147,15 +144,9 @@ func (s *SupplierServiceServer) UpdateSupplier(ctx context.Context, req *pb.Upda
 	// 查询供应商
 	if err := s.db.First(&supplier, req.GetId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.UpdateSupplierResponse{
-				Success:  false,
-				Feedback: "Supplier not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Supplier not found")
 		}
-		return &pb.UpdateSupplierResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query supplier: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query supplier: %v", err)
 	}
 
 	// 更新字段
@@ -168,24 +159,21 @@ func (s *SupplierServiceServer) UpdateSupplier(ctx context.Context, req *pb.Upda
 	if req.GetSupplyInfo() != "" {
 		supplier.SupplyInfo = req.GetSupplyInfo()
 	}
+	if req.Preferred != nil {
+		supplier.Preferred = req.GetPreferred()
+	}
 	supplier.UpdatedAt = time.Now()
 
 	// 保存更新
 	if err := s.db.Save(&supplier).Error; err != nil {
-		return &pb.UpdateSupplierResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to update supplier: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to update supplier: %v", err)
 	}
 
 	// 更新供应的书籍
 	if len(req.GetSupplyBooks()) > 0 {
 		// 删除旧的供应书籍
 		if err := s.db.Where("supplier_id = ?", supplier.ID).Delete(&models.SupplyBook{}).Error; err != nil {
-			return &pb.UpdateSupplierResponse{
-				Success:  false,
-				Feedback: fmt.Sprintf("failed to delete old supply books: %v", err),
-			}, nil
+			return nil, status.Errorf(codes.Internal, "failed to delete old supply books: %v", err)
 		}
 
 		// 添加新的供应书籍
@@ -201,10 +189,10 @@ func (s *SupplierServiceServer) UpdateSupplier(ctx context.Context, req *pb.Upda
 				UpdatedAt:     time.Now(),
 			}
 			if err := s.db.Create(&bookModel).Error; err != nil {
-				return &pb.UpdateSupplierResponse{
-					Success:  false,
-					Feedback: fmt.Sprintf("failed to create new supply book: %v", err),
-				}, nil
+				if isDuplicateKeyError(err) {
+					return nil, status.Error(codes.AlreadyExists, "Supply book already exists")
+				}
+				return nil, status.Errorf(codes.Internal, "failed to create new supply book: %v", err)
 			}
 		}
 	}
@@ -223,23 +211,14 @@ func (s *SupplierServiceServer) DeleteSupplier(ctx context.Context, req *pb.Dele
 	// 查询供应商
 	if err := s.db.First(&supplier, req.GetId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.DeleteSupplierResponse{
-				Success:  false,
-				Feedback: "Supplier not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Supplier not found")
 		}
-		return &pb.DeleteSupplierResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query supplier: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query supplier: %v", err)
 	}
 
 	// 删除供应商
 	if err := s.db.Delete(&supplier).Error; err != nil {
-		return &pb.DeleteSupplierResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to delete supplier: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to delete supplier: %v", err)
 	}
 
 	// 返回成功的响应
@@ -253,45 +232,27 @@ func (s *SupplierServiceServer) DeleteSupplier(ctx context.Context, req *pb.Dele
 func (s *SupplyBookServiceServer) CreateSupplyBook(ctx context.Context, req *pb.CreateSupplyBookRequest) (*pb.CreateSupplyBookResponse, error) {
 	// 验证用户输入
 	if req.GetBookNo() == "" {
-		return &pb.CreateSupplyBookResponse{
-			Success:  false,
-			Feedback: "Book No is required",
-		}, nil
+		return nil, invalidArgumentError("book_no", "Book No is required")
 	}
 
 	if req.GetTitle() == "" {
-		return &pb.CreateSupplyBookResponse{
-			Success:  false,
-			Feedback: "Title is required",
-		}, nil
+		return nil, invalidArgumentError("title", "Title is required")
 	}
 
 	if req.GetPublisherName() == "" {
-		return &pb.CreateSupplyBookResponse{
-			Success:  false,
-			Feedback: "Publisher Name is required",
-		}, nil
+		return nil, invalidArgumentError("publisher_name", "Publisher Name is required")
 	}
 
 	if req.GetPrice() <= 0 {
-		return &pb.CreateSupplyBookResponse{
-			Success:  false,
-			Feedback: "Price must be greater than 0",
-		}, nil
+		return nil, invalidArgumentError("price", "Price must be greater than 0")
 	}
 
 	if req.GetQuantity() <= 0 {
-		return &pb.CreateSupplyBookResponse{
-			Success:  false,
-			Feedback: "Quantity must be greater than 0",
-		}, nil
+		return nil, invalidArgumentError("quantity", "Quantity must be greater than 0")
 	}
 
 	if req.GetSupplierId() <= 0 {
-		return &pb.CreateSupplyBookResponse{
-			Success:  false,
-			Feedback: "Supplier ID is required",
-		}, nil
+		return nil, invalidArgumentError("supplier_id", "Supplier ID is required")
 	}
 
 	// 构建新的 SupplyBook 对象
@@ -308,10 +269,10 @@ func (s *SupplyBookServiceServer) CreateSupplyBook(ctx context.Context, req *pb.
 
 	// 写入数据库
 	if err := s.db.Create(&supplyBook).Error; err != nil {
-		return &pb.CreateSupplyBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to create supply book: %v", err),
-		}, nil
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "Supply book already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create supply book: %v", err)
 	}
 
 	// 返回成功的响应
@@ -327,10 +288,7 @@ func (s *SupplyBookServiceServer) GetSupplyBooksBySupplier(ctx context.Context,
 
 	// 查询供书记录
 	if err := s.db.Where("supplier_id = ?", req.GetSupplierId()).Find(&supplyBooks).Error; err != nil {
-		return &pb.GetSupplyBooksBySupplierResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query supply books: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query supply books: %v", err)
 	}
 
 	// 构建响应
@@ -365,15 +323,9 @@ func (s *SupplyBookServiceServer) GetSupplyBookByID(ctx context.Context, req *pb
 	// 查询供书记录
 	if err := s.db.First(&supplyBook, req.GetId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.GetSupplyBookByIDResponse{
-				Success:  false,
-				Feedback: "Supply book not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Supply book not found")
 		}
-		return &pb.GetSupplyBookByIDResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query supply book: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query supply book: %v", err)
 	}
 
 	// 返回成功的响应
@@ -401,15 +353,9 @@ func (s *SupplyBookServiceServer) UpdateSupplyBook(ctx context.Context, req *pb.
 	// 查询供书记录
 	if err := s.db.First(&supplyBook, req.GetId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.UpdateSupplyBookResponse{
-				Success:  false,
-				Feedback: "Supply book not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Supply book not found")
 		}
-		return &pb.UpdateSupplyBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query supply book: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query supply book: %v", err)
 	}
 
 	// 更新字段
@@ -435,10 +381,10 @@ func (s *SupplyBookServiceServer) UpdateSupplyBook(ctx context.Context, req *pb.
 
 	// 保存更新
 	if err := s.db.Save(&supplyBook).Error; err != nil {
-		return &pb.UpdateSupplyBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to update supply book: %v", err),
-		}, nil
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "Supply book already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update supply book: %v", err)
 	}
 
 	// 返回成功的响应
@@ -455,23 +401,14 @@ func (s *SupplyBookServiceServer) DeleteSupplyBook(ctx context.Context, req *pb.
 	// 查询供书记录
 	if err := s.db.First(&supplyBook, req.GetId()).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &pb.DeleteSupplyBookResponse{
-				Success:  false,
-				Feedback: "Supply book not found",
-			}, nil
+			return nil, status.Error(codes.NotFound, "Supply book not found")
 		}
-		return &pb.DeleteSupplyBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to query supply book: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to query supply book: %v", err)
 	}
 
 	// 删除供书记录
 	if err := s.db.Delete(&supplyBook).Error; err != nil {
-		return &pb.DeleteSupplyBookResponse{
-			Success:  false,
-			Feedback: fmt.Sprintf("failed to delete supply book: %v", err),
-		}, nil
+		return nil, status.Errorf(codes.Internal, "failed to delete supply book: %v", err)
 	}
 
 	// 返回成功的响应
@@ -6,22 +6,11 @@ import (
 	"testing"
 
 	pb "github.com/GoldenStain/goDB/bookstorepb"
-	"github.com/GoldenStain/goDB/models"
 	"github.com/stretchr/testify/assert"
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
 )
 
-func setupTestDBSupplierService(t *testing.T) *gorm.DB {
-	db, _ := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
-	if err := models.AutoMigrate(db); err != nil {
-		t.Fatalf("failed to migrate database: %v", err)
-	}
-	return db
-}
-
 func TestSupplierService(t *testing.T) {
-	db := setupTestDBSupplierService(t)
+	db := setupTestDB(t)
 	supplierServer := NewSupplierServiceServer(db)
 	supplyBookServer := NewSupplyBookServiceServer(db)
 
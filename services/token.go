@@ -0,0 +1,83 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	errInvalidToken = errors.New("invalid token")
+	errTokenExpired = errors.New("token expired")
+)
+
+// TokenClaims 是令牌中携带的信息
+type TokenClaims struct {
+	// 客户的在线ID
+	OnlineID  string `json:"sub"`
+	Role      string `json:"role"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// TokenSigner 用 HMAC-SHA256 签发和验证登录令牌，令牌格式为 base64(claims).base64(signature)
+type TokenSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewTokenSigner 用于创建 TokenSigner，ttl 为令牌的有效期
+func NewTokenSigner(secret []byte, ttl time.Duration) *TokenSigner {
+	return &TokenSigner{
+		secret: secret,
+		ttl:    ttl,
+	}
+}
+
+// Sign 为指定客户签发令牌，返回令牌和过期时间
+func (s *TokenSigner) Sign(onlineID, role string) (string, time.Time, error) {
+	expiresAt := time.Now().Add(s.ttl)
+	payload, err := json.Marshal(TokenClaims{
+		OnlineID:  onlineID,
+		Role:      role,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + s.signature(encoded), expiresAt, nil
+}
+
+// Verify 验证令牌的签名和有效期，返回令牌中的信息
+func (s *TokenSigner) Verify(token string) (*TokenClaims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.signature(encoded))) {
+		return nil, errInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	var claims TokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errInvalidToken
+	}
+
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, errTokenExpired
+	}
+	return &claims, nil
+}
+
+// signature 计算令牌内容的签名
+func (s *TokenSigner) signature(encoded string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
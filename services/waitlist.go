@@ -0,0 +1,258 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/notification"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// fulfilWaitlist 在书籍 bookNo 到货后按登记的先后顺序处理等待的客户，返回应答中的说明，没有等待的客户时返回空字符串。
+// 缺货订单的登记为订单保留库存，可以自动下单的登记直接创建已付款的订单，其他登记和余额不足的客户改为通知书目已经上新。
+// 库存不够排在前面的客户，或者库存被并发的订单占用时停止处理，后面的客户继续等待下一次到货。
+// tx 是增加库存的事务，订单和通知与库存一起提交，扣除的库存以 actor 的名义记为销售。
+func fulfilWaitlist(tx *gorm.DB, bookNo string, actor string) (string, error) {
+	var book models.Book
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("book_no = ?", bookNo).First(&book).Error; err != nil {
+		return "", status.Errorf(codes.Internal, "Failed to query book: %v", err)
+	}
+
+	var entries []*models.StockWaitlist
+	if err := tx.Where("book_no = ? AND status = ?", bookNo, models.WaitlistWaiting).Order("id").Find(&entries).Error; err != nil {
+		return "", status.Errorf(codes.Internal, "Failed to query stock waitlist: %v", err)
+	}
+	if len(entries) == 0 {
+		return "", nil
+	}
+
+	fulfilled, reserved, notified := 0, 0, 0
+	stock := book.AvailableQuantity()
+	for _, entry := range entries {
+		if stock < entry.Quantity {
+			break
+		}
+
+		// 缺货订单已经付款，只需要保留库存
+		if entry.CustomerOrderID != nil {
+			ok, err := reserveBackorder(tx, entry, actor)
+			if err == errInsufficientStock {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+			if ok {
+				stock -= entry.Quantity
+				reserved++
+			}
+			continue
+		}
+
+		// 客户已经注销时取消登记
+		var customer models.Customer
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("online_id = ?", entry.CustomerOnlineID).First(&customer).Error; err != nil {
+			if err != gorm.ErrRecordNotFound {
+				return "", status.Errorf(codes.Internal, "Failed to query customer: %v", err)
+			}
+			if err := updateWaitlistStatus(tx, entry, models.WaitlistCancelled, nil); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		if entry.AutoFulfill {
+			order, err := fulfilWaitlistEntry(tx, &customer, entry, actor)
+			if err == errInsufficientStock {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+			if order != nil {
+				data := notification.WaitlistFulfilledData{
+					CustomerName: customer.Name,
+					BookNo:       book.BookNo,
+					Title:        book.Title,
+					Quantity:     entry.Quantity,
+					OrderID:      order.ID,
+					PaidAmount:   order.PaidAmount,
+				}
+				if _, err := notifyCustomer(tx, &customer, notification.EventWaitlistFulfilled, data); err != nil {
+					return "", err
+				}
+				stock -= entry.Quantity
+				fulfilled++
+				continue
+			}
+		}
+
+		// 通知客户自己下单，库存不为客户保留
+		data := notification.BookAvailableData{CustomerName: customer.Name, BookNo: book.BookNo, Title: book.Title}
+		if _, err := notifyCustomer(tx, &customer, notification.EventBookAvailable, data); err != nil {
+			return "", err
+		}
+		if err := updateWaitlistStatus(tx, entry, models.WaitlistNotified, nil); err != nil {
+			return "", err
+		}
+		notified++
+	}
+
+	return fmt.Sprintf("已为%d位等待到货的客户自动下单，为%d个缺货订单保留了库存，通知了%d位客户", fulfilled, reserved, notified), nil
+}
+
+// reserveBackorder 为缺货订单扣除到货的库存，订单中所有缺货的书都到货后订单变为 ready。
+// 订单已经取消或删除时取消登记并返回 false。
+func reserveBackorder(tx *gorm.DB, entry *models.StockWaitlist, actor string) (bool, error) {
+	var order models.CustomerOrder
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items", orderByID).First(&order, *entry.CustomerOrderID).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			return false, status.Errorf(codes.Internal, "Failed to query customer order: %v", err)
+		}
+		return false, updateWaitlistStatus(tx, entry, models.WaitlistCancelled, entry.CustomerOrderID)
+	}
+	if order.Status != models.OrderStatusBackordered {
+		return false, updateWaitlistStatus(tx, entry, models.WaitlistCancelled, entry.CustomerOrderID)
+	}
+
+	// 扣除库存，带条件更新，保证不会用到其他客户预留的库存
+	result := tx.Model(&models.Book{}).
+		Where("book_no = ? AND stock_quantity - reserved_quantity >= ?", entry.BookNo, entry.Quantity).
+		Update("stock_quantity", gorm.Expr("stock_quantity - ?", entry.Quantity))
+	if result.Error != nil {
+		return false, status.Errorf(codes.Internal, "Failed to update stock: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, errInsufficientStock
+	}
+	if err := recordStockMovement(tx, entry.BookNo, -entry.Quantity, models.StockMovementSale, order.ID, actor); err != nil {
+		return false, err
+	}
+
+	// 依次减少同一本书各行的缺货数量
+	remaining := entry.Quantity
+	stillBackordered := false
+	for _, item := range order.Items {
+		if item.BookNo == entry.BookNo && remaining > 0 && item.BackorderedCount > 0 {
+			count := min(item.BackorderedCount, remaining)
+			remaining -= count
+			item.BackorderedCount -= count
+			if err := tx.Model(item).Update("backordered_count", item.BackorderedCount).Error; err != nil {
+				return false, status.Errorf(codes.Internal, "Failed to update customer order item: %v", err)
+			}
+		}
+		if item.BackorderedCount > 0 {
+			stillBackordered = true
+		}
+	}
+
+	if err := updateWaitlistStatus(tx, entry, models.WaitlistFulfilled, entry.CustomerOrderID); err != nil {
+		return false, err
+	}
+	if stillBackordered {
+		return true, nil
+	}
+	return true, transitionOrderStatus(tx, &order, models.OrderStatusReady)
+}
+
+// fulfilWaitlistEntry 按等待登记为客户创建已付款的订单，客户余额不足时返回空订单。
+// 下单在保存点中进行，余额或库存不足时撤销已经做出的修改，库存不足时返回 errInsufficientStock。
+func fulfilWaitlistEntry(tx *gorm.DB, customer *models.Customer, entry *models.StockWaitlist, actor string) (*models.CustomerOrder, error) {
+	order := &models.CustomerOrder{
+		OrderDate:        time.Now().Format("2006-01-02"),
+		CustomerOnlineID: entry.CustomerOnlineID,
+		Address:          entry.Address,
+		Items: []*models.CustomerOrderItem{{
+			BookNo:    entry.BookNo,
+			BookCount: entry.Quantity,
+			Price:     entry.Price,
+		}},
+	}
+	err := tx.Transaction(func(tx *gorm.DB) error {
+		if err := placePaidOrder(tx, customer, order, actor); err != nil {
+			return err
+		}
+		return updateWaitlistStatus(tx, entry, models.WaitlistFulfilled, &order.ID)
+	})
+	if err == errInsufficientBalance {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// addToWaitlist 登记客户等待书籍到货，缺少的 shortfall 本创建缺书登记。
+// 客户已经在等待这本书时不重复登记，改为更新原来的登记，数量增加的部分追加到缺书登记，
+// 这样重复下单的客户到货后只会自动下单扣款一次
+func addToWaitlist(tx *gorm.DB, book *models.Book, entry *models.StockWaitlist, shortfall int32) error {
+	var existing models.StockWaitlist
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("customer_online_id = ? AND book_no = ? AND status = ? AND customer_order_id IS NULL", entry.CustomerOnlineID, entry.BookNo, models.WaitlistWaiting).
+		Order("id").First(&existing).Error
+	if err == gorm.ErrRecordNotFound {
+		stockRequest, err := createStockRequest(tx, book, shortfall, nil)
+		if err != nil {
+			return err
+		}
+		entry.StockRequestID = stockRequest.ID
+		if err := tx.Create(entry).Error; err != nil {
+			return status.Errorf(codes.Internal, "Failed to create stock waitlist: %v", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to query stock waitlist: %v", err)
+	}
+
+	// 缺书登记已经生成采购单时，增加的部分另外创建缺书登记
+	stockRequestID := existing.StockRequestID
+	if increase := entry.Quantity - existing.Quantity; increase > 0 {
+		result := tx.Model(&models.StockRequest{}).
+			Where("id = ? AND finished = ?", existing.StockRequestID, false).
+			Updates(map[string]interface{}{"quantity": gorm.Expr("quantity + ?", increase), "updated_at": time.Now()})
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "Failed to update stock request: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			stockRequest, err := createStockRequest(tx, book, increase, nil)
+			if err != nil {
+				return err
+			}
+			stockRequestID = stockRequest.ID
+		}
+	}
+
+	updates := map[string]interface{}{
+		"quantity":         entry.Quantity,
+		"price":            entry.Price,
+		"address":          entry.Address,
+		"auto_fulfill":     entry.AutoFulfill,
+		"stock_request_id": stockRequestID,
+		"updated_at":       time.Now(),
+	}
+	if err := tx.Model(&models.StockWaitlist{}).Where("id = ?", existing.ID).Updates(updates).Error; err != nil {
+		return status.Errorf(codes.Internal, "Failed to update stock waitlist: %v", err)
+	}
+	return nil
+}
+
+// updateWaitlistStatus 更新等待登记的状态和自动创建的订单
+func updateWaitlistStatus(tx *gorm.DB, entry *models.StockWaitlist, waitlistStatus string, orderID *int32) error {
+	entry.Status = waitlistStatus
+	entry.CustomerOrderID = orderID
+	updates := map[string]interface{}{
+		"status":            waitlistStatus,
+		"customer_order_id": orderID,
+		"updated_at":        time.Now(),
+	}
+	if err := tx.Model(&models.StockWaitlist{}).Where("id = ?", entry.ID).Updates(updates).Error; err != nil {
+		return status.Errorf(codes.Internal, "Failed to update stock waitlist: %v", err)
+	}
+	return nil
+}
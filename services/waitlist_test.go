@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/GoldenStain/goDB/bookstorepb"
+	"github.com/GoldenStain/goDB/models"
+	"github.com/GoldenStain/goDB/notification"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestFulfilWaitlist(t *testing.T) {
+	db := setupTestDB(t)
+	customerOrderServer := NewCustomerOrderServiceServer(db)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+
+	// 添加客户和没有库存的书籍
+	customers := []models.Customer{
+		{OnlineID: "customer1", Name: "Customer 1", Address: "Address 1", AccountBalance: 1000, CreditLevel: 1, Email: "customer1@example.com"},
+		{OnlineID: "customer2", Name: "Customer 2", Address: "Address 2", AccountBalance: 0, CreditLevel: 1, Email: "customer2@example.com"},
+		{OnlineID: "customer3", Name: "Customer 3", Address: "Address 3", AccountBalance: 1000, CreditLevel: 1, Email: "customer3@example.com"},
+		{OnlineID: "customer4", Name: "Customer 4", Address: "Address 4", AccountBalance: 1000, CreditLevel: 1, Email: "customer4@example.com"},
+	}
+	for i := range customers {
+		db.Create(&customers[i])
+	}
+	db.Create(&models.Book{BookNo: "B001", Title: "Book 1", PublisherName: "Publisher", Authors: "Author"})
+	db.Create(&models.Book{BookNo: "B002", Title: "Book 2", PublisherName: "Publisher", Authors: "Author"})
+
+	// 按顺序下单：余额足够、余额不足、同时订购两本书、需要的数量超过到货数量，除了两本书的订单都同意自动下单
+	requests := []*pb.CreateCustomerOrderRequest{
+		{CustomerOnlineId: "customer1", Items: []*pb.CustomerOrderItem{{BookNo: "B001", BookCount: 2, Price: 200}}, AutoFulfill: true},
+		{CustomerOnlineId: "customer2", Items: []*pb.CustomerOrderItem{{BookNo: "B001", BookCount: 2, Price: 200}}, AutoFulfill: true},
+		{CustomerOnlineId: "customer3", Items: []*pb.CustomerOrderItem{{BookNo: "B001", BookCount: 1, Price: 100}, {BookNo: "B002", BookCount: 1, Price: 100}}},
+		{CustomerOnlineId: "customer4", Items: []*pb.CustomerOrderItem{{BookNo: "B001", BookCount: 5, Price: 500}}, AutoFulfill: true},
+	}
+	for _, req := range requests {
+		req.OrderDate = "2023-01-01"
+		req.Address = "Waitlist Address"
+		_, err := customerOrderServer.CreateCustomerOrder(context.Background(), req)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+
+	// 没有到货的采购单不处理等待的客户
+	db.Create(&models.PurchaseOrder{Supplier: "Supplier", OrderDate: "2023-01-02", Items: []*models.PurchaseOrderItem{{BookNo: "B001", Title: "Book 1", Publisher: "Publisher", Author: "Author", Quantity: 5}}})
+	resp, err := purchaseOrderServer.UpdatePurchaseOrder(context.Background(), &pb.UpdatePurchaseOrderRequest{Id: 1, Quantity: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, "Purchase order updated successfully", resp.Feedback)
+
+	// 到货后按先后顺序处理，库存不够第四位客户时停止
+	resp, err = purchaseOrderServer.UpdatePurchaseOrder(context.Background(), &pb.UpdatePurchaseOrderRequest{Id: 1, Finished: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "Purchase order updated successfully\n已为1位等待到货的客户自动下单，为0个缺货订单保留了库存，通知了2位客户", resp.Feedback)
+
+	var entries []models.StockWaitlist
+	db.Where("book_no = ?", "B001").Order("id").Find(&entries)
+	assert.Equal(t, 4, len(entries))
+	assert.Equal(t, models.WaitlistFulfilled, entries[0].Status)
+	assert.Equal(t, models.WaitlistNotified, entries[1].Status)
+	assert.Equal(t, models.WaitlistNotified, entries[2].Status)
+	assert.Equal(t, models.WaitlistWaiting, entries[3].Status)
+
+	// 自动创建的订单按客户的折扣扣款，扣除库存
+	var order models.CustomerOrder
+	assert.NoError(t, db.Preload("Items").First(&order, *entries[0].CustomerOrderID).Error)
+	assert.Equal(t, "customer1", order.CustomerOnlineID)
+	assert.Equal(t, models.OrderStatusPaid, order.Status)
+	assert.Equal(t, "Waitlist Address", order.Address)
+	assert.Equal(t, int32(180), order.PaidAmount)
+	assert.Equal(t, int32(2), order.Items[0].BookCount)
+	var customer models.Customer
+	db.First(&customer, "online_id = ?", "customer1")
+	assert.Equal(t, int32(820), customer.AccountBalance)
+	var book models.Book
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(3), book.StockQuantity)
+
+	// 自动下单的客户收到下单通知，其他客户收到上新通知
+	var messages []models.OutboxMessage
+	db.Order("id").Find(&messages)
+	var sent []string
+	for _, message := range messages {
+		sent = append(sent, message.Recipient+"/"+message.Event)
+	}
+	assert.Equal(t, []string{
+		"customer1/" + notification.EventWaitlistFulfilled,
+		"customer2/" + notification.EventBookAvailable,
+		"customer3/" + notification.EventBookAvailable,
+	}, sent)
+
+	// 再次标记到货不会重复增加库存和处理等待的客户
+	resp, err = purchaseOrderServer.UpdatePurchaseOrder(context.Background(), &pb.UpdatePurchaseOrderRequest{Id: 1, Finished: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "Purchase order updated successfully", resp.Feedback)
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(3), book.StockQuantity)
+}
+
+func TestWaitlistDeduplicatesRetries(t *testing.T) {
+	db := setupTestDB(t)
+	customerOrderServer := NewCustomerOrderServiceServer(db)
+	db.Create(&models.Customer{OnlineID: "customer1", Name: "Customer 1", Address: "Address 1", AccountBalance: 1000, CreditLevel: 1})
+	db.Create(&models.Book{BookNo: "B001", Title: "Book 1", PublisherName: "Publisher", Authors: "Author", StockQuantity: 1})
+	db.Create(&models.Book{BookNo: "B002", Title: "Book 2", PublisherName: "Publisher", Authors: "Author"})
+
+	order := func(count int32, autoFulfill bool) {
+		_, err := customerOrderServer.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+			OrderDate: "2023-01-01", CustomerOnlineId: "customer1", BookNo: "B001", BookCount: count, Price: 100 * count, Address: "Address 1", AutoFulfill: autoFulfill,
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+
+	// 自动下单只能用于只有一本书、不允许缺货的订单
+	_, err := customerOrderServer.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate: "2023-01-01", CustomerOnlineId: "customer1", Address: "Address 1", AutoFulfill: true,
+		Items: []*pb.CustomerOrderItem{{BookNo: "B001", BookCount: 1, Price: 100}, {BookNo: "B002", BookCount: 1, Price: 100}},
+	})
+	assert.Equal(t, "auto_fulfill", badRequestField(t, err))
+	_, err = customerOrderServer.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate: "2023-01-01", CustomerOnlineId: "customer1", BookNo: "B001", BookCount: 3, Price: 300, Address: "Address 1", AutoFulfill: true, AllowBackorder: true,
+	})
+	assert.Equal(t, "auto_fulfill", badRequestField(t, err))
+
+	// 重复下单只保留一条登记，更新为最后一次的数量和选择，缺书登记只追加增加的数量
+	order(3, false)
+	order(3, true)
+	order(4, true)
+	var entries []models.StockWaitlist
+	db.Find(&entries)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, int32(4), entries[0].Quantity)
+	assert.Equal(t, int32(400), entries[0].Price)
+	assert.True(t, entries[0].AutoFulfill)
+	var stockRequests []models.StockRequest
+	db.Find(&stockRequests)
+	assert.Len(t, stockRequests, 1)
+	assert.Equal(t, int32(3), stockRequests[0].Quantity)
+
+	// 缺书登记已经生成采购单后，增加的数量另外登记
+	db.Model(&models.StockRequest{}).Where("id = ?", stockRequests[0].ID).Update("finished", true)
+	order(6, true)
+	db.Order("id").Find(&stockRequests)
+	assert.Len(t, stockRequests, 2)
+	assert.Equal(t, int32(2), stockRequests[1].Quantity)
+	db.Find(&entries)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, stockRequests[1].ID, entries[0].StockRequestID)
+}
+
+func TestFulfilWaitlistStockTaken(t *testing.T) {
+	db := setupTestDB(t)
+	customerOrderServer := NewCustomerOrderServiceServer(db)
+	purchaseOrderServer := NewPurchaseOrderServiceServer(db)
+	db.Create(&models.Customer{OnlineID: "customer1", Name: "Customer 1", Address: "Address 1", AccountBalance: 1000, CreditLevel: 1})
+	db.Create(&models.Book{BookNo: "B001", Title: "Book 1", PublisherName: "Publisher", Authors: "Author"})
+	_, err := customerOrderServer.CreateCustomerOrder(context.Background(), &pb.CreateCustomerOrderRequest{
+		OrderDate: "2023-01-01", CustomerOnlineId: "customer1", BookNo: "B001", BookCount: 2, Price: 200, Address: "Address 1", AutoFulfill: true,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// 读取等待登记之后，并发的订单买走了大部分到货的书
+	taken := false
+	db.Callback().Query().After("gorm:query").Register("test:take_stock", func(tx *gorm.DB) {
+		if !taken && tx.Statement.Table == "stock_waitlists" {
+			taken = true
+			tx.Session(&gorm.Session{NewDB: true}).Exec("UPDATE books SET stock_quantity = stock_quantity - 4 WHERE book_no = ?", "B001")
+		}
+	})
+
+	// 库存不够时停止自动下单，到货仍然入库，客户继续等待
+	db.Create(&models.PurchaseOrder{Supplier: "Supplier", OrderDate: "2023-01-02", Items: []*models.PurchaseOrderItem{{BookNo: "B001", Title: "Book 1", Publisher: "Publisher", Author: "Author", Quantity: 5}}})
+	_, err = purchaseOrderServer.UpdatePurchaseOrder(context.Background(), &pb.UpdatePurchaseOrderRequest{Id: 1, Finished: true})
+	assert.NoError(t, err)
+	assert.True(t, taken)
+	var book models.Book
+	db.First(&book, "book_no = ?", "B001")
+	assert.Equal(t, int32(1), book.StockQuantity)
+	var purchaseOrder models.PurchaseOrder
+	db.First(&purchaseOrder, 1)
+	assert.True(t, purchaseOrder.Finished)
+	var entry models.StockWaitlist
+	db.First(&entry)
+	assert.Equal(t, models.WaitlistWaiting, entry.Status)
+	var count int64
+	db.Model(&models.CustomerOrder{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+}